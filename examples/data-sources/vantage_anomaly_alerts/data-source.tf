@@ -0,0 +1,11 @@
+# Open anomalies detected on a cost report in January 2024
+data "vantage_anomaly_alerts" "open" {
+  cost_report_token = vantage_cost_report.demo_report.token
+  status            = "active"
+  start_date        = "2024-01-01"
+  end_date          = "2024-01-31"
+}
+
+output "open_anomaly_tokens" {
+  value = [for a in data.vantage_anomaly_alerts.open.anomaly_alerts : a.token]
+}
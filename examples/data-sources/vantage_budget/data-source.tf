@@ -0,0 +1,3 @@
+data "vantage_budget" "q1" {
+  name = "Q1 Cloud Budget"
+}
@@ -0,0 +1,10 @@
+data "vantage_budget_hierarchy" "engineering" {
+  budget_token = vantage_budget.engineering.token
+}
+
+output "over_budget_teams" {
+  value = [
+    for n in data.vantage_budget_hierarchy.engineering.nodes : n.name
+    if length(n.performance) > 0 && tonumber(n.performance[length(n.performance) - 1].actual) > tonumber(n.performance[length(n.performance) - 1].amount)
+  ]
+}
@@ -0,0 +1,12 @@
+data "vantage_budget_performance" "production" {
+  budget_token = "bdgt_1234567890abcdef"
+}
+
+resource "terraform_data" "deploy" {
+  lifecycle {
+    precondition {
+      condition     = !data.vantage_budget_performance.production.over_budget
+      error_message = "The production budget is forecast to be exceeded this period."
+    }
+  }
+}
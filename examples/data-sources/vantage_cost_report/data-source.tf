@@ -0,0 +1,8 @@
+data "vantage_folder" "finance" {
+  title = "Finance"
+}
+
+data "vantage_cost_report" "aws_spend" {
+  title        = "AWS Spend"
+  folder_token = data.vantage_folder.finance.token
+}
@@ -0,0 +1,3 @@
+data "vantage_dashboard" "overview" {
+  title = "Engineering Overview"
+}
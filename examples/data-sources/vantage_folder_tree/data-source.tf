@@ -0,0 +1,16 @@
+data "vantage_folder_tree" "engineering" {
+  workspace_token = "wrkspc_47c3254c790e9351"
+  path            = "Engineering/Platform/Prod"
+}
+
+resource "vantage_cost_report" "prod_spend" {
+  title        = "Prod Spend"
+  folder_token = data.vantage_folder_tree.engineering.token
+  filter       = "costs.provider = 'aws'"
+}
+
+output "engineering_folders" {
+  value = {
+    for f in data.vantage_folder_tree.engineering.folders : f.path => length(f.cost_report_tokens)
+  }
+}
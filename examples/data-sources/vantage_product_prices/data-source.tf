@@ -0,0 +1,11 @@
+data "vantage_product_prices" "m5_large" {
+  product_id      = "aws-ec2-m5_large"
+  region          = "us-east-1"
+  purchase_option = "on_demand"
+}
+
+# Monthly cost of ten m5.large instances at the published hourly rate.
+locals {
+  m5_large_hourly = data.vantage_product_prices.m5_large.prices[0].amount
+  fleet_monthly   = local.m5_large_hourly * 730 * 10
+}
@@ -0,0 +1,9 @@
+data "vantage_products" "m5" {
+  provider_id = "aws"
+  service_id  = "aws-ec2"
+  name        = "m5."
+}
+
+output "m5_product_ids" {
+  value = [for p in data.vantage_products.m5.products : p.id]
+}
@@ -0,0 +1,24 @@
+# Open recommendations for an existing recommendation view
+data "vantage_recommendations" "from_view" {
+  recommendation_view_token = vantage_recommendation_view.production.token
+  status                    = "open"
+  include_resources         = true
+}
+
+# The same filters a recommendation view accepts, without creating a view
+data "vantage_recommendations" "us_east" {
+  workspace_token = "wrkspc_1234567890abcdef"
+  provider_ids    = ["aws"]
+  regions         = ["us-east-1"]
+}
+
+output "ticket_candidates" {
+  value = [
+    for r in data.vantage_recommendations.from_view.recommendations : {
+      token             = r.token
+      category          = r.category
+      potential_savings = r.potential_savings
+      resources         = r.resources
+    }
+  ]
+}
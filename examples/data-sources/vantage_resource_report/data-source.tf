@@ -0,0 +1,3 @@
+data "vantage_resource_report" "idle_instances" {
+  token = "prvdr_rsrc_rprt_1234567890abcdef"
+}
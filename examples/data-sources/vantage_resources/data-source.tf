@@ -0,0 +1,18 @@
+# Resources matched by an existing resource report
+data "vantage_resources" "report" {
+  resource_report_token = vantage_resource_report.instances.token
+  include_costs         = true
+}
+
+# Resources matched by an ad-hoc VQL filter
+data "vantage_resources" "untagged_instances" {
+  workspace_token = "wrkspc_1234567890abcdef"
+  filter          = "resources.provider = 'aws' AND resources.type = 'aws_instance'"
+}
+
+output "untagged_instance_ids" {
+  value = [
+    for r in data.vantage_resources.untagged_instances.resources : r.provider_id
+    if !contains(keys(try(jsondecode(r.metadata).tags, {})), "team")
+  ]
+}
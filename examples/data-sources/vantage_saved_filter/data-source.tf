@@ -0,0 +1,4 @@
+data "vantage_saved_filter" "production" {
+  title           = "Production"
+  workspace_token = "wrkspc_47c3254c790e9351"
+}
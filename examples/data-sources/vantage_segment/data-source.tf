@@ -0,0 +1,3 @@
+data "vantage_segment" "platform" {
+  title = "Platform"
+}
@@ -0,0 +1,12 @@
+data "vantage_tag_values" "teams" {
+  tag_key   = "team"
+  providers = ["aws"]
+}
+
+# Build one VQL filter per team from the values actually present on costs.
+locals {
+  team_filters = {
+    for v in data.vantage_tag_values.teams.values :
+    v => "(costs.provider = 'aws' AND tags.name = 'team' AND tags.value = '${v}')"
+  }
+}
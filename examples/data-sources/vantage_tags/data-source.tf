@@ -0,0 +1,8 @@
+data "vantage_tags" "aws_team_keys" {
+  providers = ["aws"]
+  search    = "team"
+}
+
+output "aws_team_tag_keys" {
+  value = [for t in data.vantage_tags.aws_team_keys.tags : t.tag_key if !t.hidden]
+}
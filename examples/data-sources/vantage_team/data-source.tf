@@ -0,0 +1,8 @@
+data "vantage_team" "platform" {
+  name = "Platform"
+}
+
+resource "vantage_access_grant" "platform_report" {
+  team_token     = data.vantage_team.platform.token
+  resource_token = "rprt_39d256c871cb6b2b"
+}
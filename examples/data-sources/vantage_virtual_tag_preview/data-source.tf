@@ -0,0 +1,24 @@
+data "vantage_virtual_tag_preview" "team" {
+  key        = "Team"
+  filter     = "costs.provider = 'aws'"
+  start_date = "2025-01-01"
+  end_date   = "2025-01-31"
+
+  values = [
+    {
+      name   = "Platform"
+      filter = "(costs.provider = 'aws' AND tags.name = 'team' AND tags.value = 'platform')"
+    },
+    {
+      filter = "(costs.provider = 'aws' AND costs.service = 'Amazon Relational Database Service')"
+      percentages = [
+        { value = "Data", pct = 60 },
+        { value = "Platform", pct = 40 },
+      ]
+    },
+  ]
+}
+
+output "team_tag_coverage" {
+  value = data.vantage_virtual_tag_preview.team.coverage_percentage
+}
@@ -0,0 +1,14 @@
+resource "vantage_access_grants" "cost_report" {
+  resource_token = vantage_cost_report.demo_report.token
+
+  grants = [
+    {
+      team_token = "team_bd5c2d8abc233bfd"
+      access     = "allowed"
+    },
+    {
+      team_token = "team_7a2c1e7d4f0b9a31"
+      access     = "denied"
+    },
+  ]
+}
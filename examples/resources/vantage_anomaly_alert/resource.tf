@@ -0,0 +1,5 @@
+resource "vantage_anomaly_alert" "expected_spike" {
+  anomaly_alert_token = "anmly_rprt_alrt_1234567890abcdef"
+  status              = "archived"
+  feedback            = "Expected spike from the quarterly load test."
+}
@@ -0,0 +1,11 @@
+resource "vantage_aws_integration" "management" {
+  cross_account_arn = "arn:aws:iam::123456789012:role/vantage-cross-account"
+  bucket_arn        = "arn:aws:s3:::vantage-cur-123456789012"
+  workspaces        = ["wrkspc_1234567890abcdef"]
+}
+
+# Migrate an existing vantage_aws_provider without recreating the integration.
+moved {
+  from = vantage_aws_provider.management
+  to   = vantage_aws_integration.management
+}
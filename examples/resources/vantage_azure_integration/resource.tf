@@ -0,0 +1,12 @@
+variable "vantage_azure_client_secret" {
+  type      = string
+  sensitive = true
+}
+
+resource "vantage_azure_integration" "production" {
+  tenant_id        = "00000000-0000-0000-0000-000000000000"
+  app_id           = "11111111-1111-1111-1111-111111111111"
+  client_secret    = var.vantage_azure_client_secret
+  subscription_ids = ["22222222-2222-2222-2222-222222222222"]
+  workspaces       = ["wrkspc_1234567890abcdef"]
+}
@@ -9,3 +9,26 @@ resource "vantage_budget" "demo_budget" {
     }
   ]
 }
+
+resource "vantage_budget" "scheduled_budget" {
+  name              = "Scheduled Budget"
+  cost_report_token = vantage_cost_report.demo_report.token
+  schedule = {
+    start_month       = "2025-01"
+    period_count      = 36
+    cadence           = "monthly"
+    amount            = 10000
+    growth_percentage = 1.5
+    overrides = {
+      "2025-12-01" = 15000
+    }
+  }
+}
+
+resource "vantage_budget" "engineering" {
+  name = "Engineering"
+  child_budget_tokens = [
+    vantage_budget.demo_budget.token,
+    vantage_budget.scheduled_budget.token,
+  ]
+}
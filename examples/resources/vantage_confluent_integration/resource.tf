@@ -0,0 +1,9 @@
+variable "confluent_api_secret" {
+  type      = string
+  sensitive = true
+}
+
+resource "vantage_confluent_integration" "main" {
+  api_key    = "ABCDEFGHIJKLMNOP"
+  api_secret = var.confluent_api_secret
+}
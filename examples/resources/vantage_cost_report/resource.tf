@@ -6,6 +6,12 @@ resource "vantage_cost_report" "demo_report" {
   workspace_token     = "wrkspc_47c3254c790e9351"
   chart_type          = "line" # Allowed: area, line, pie, bar, multi-bar
   date_bin            = "day"  # Allowed: cumulative, day, week, month, quarter
+  grouping_list       = ["provider", "service", "tag:team"]
+
+  chart_settings = {
+    x_axis_dimension = ["service"] # "date" or one of the groupings
+    y_axis_dimension = "cost"
+  }
 
   settings = {
     include_credits      = true
@@ -21,3 +27,18 @@ resource "vantage_cost_report" "demo_report" {
   # optionally, use folder_token instead of workspace_token
   # folder_token = "fldr_47c3254c790e9351"
 }
+
+resource "vantage_cost_report" "tagged_report" {
+  title           = "Web Team EC2 and S3"
+  workspace_token = "wrkspc_47c3254c790e9351"
+
+  # rendered to the filter
+  # costs.provider = 'aws' AND costs.service IN ('AmazonEC2', 'AmazonS3') AND (tags.name = 'team' AND tags.value = 'web')
+  filter_rules = {
+    providers = ["aws"]
+    services  = ["AmazonEC2", "AmazonS3"]
+    tags = [
+      { key = "team", values = ["web"] },
+    ]
+  }
+}
@@ -0,0 +1,24 @@
+variable "datadog_api_key" {
+  type      = string
+  sensitive = true
+}
+
+variable "datadog_application_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "vantage_datadog_integration" "main" {
+  api_key         = var.datadog_api_key
+  application_key = var.datadog_application_key
+  site            = "datadoghq.eu"
+  workspaces      = ["wrkspc_1234567890abcdef"]
+}
+
+resource "vantage_business_metric" "requests" {
+  title = "Requests"
+  datadog_metric_fields = {
+    integration_token = vantage_datadog_integration.main.token
+    query             = "sum:aws.applicationelb.request_count{*}.rollup(sum, daily)"
+  }
+}
@@ -0,0 +1,6 @@
+resource "vantage_gcp_integration" "billing" {
+  billing_account_id = "012345-6789AB-CDEF01"
+  project_id         = "billing-exports"
+  dataset_name       = "all_billing_data"
+  workspaces         = ["wrkspc_1234567890abcdef"]
+}
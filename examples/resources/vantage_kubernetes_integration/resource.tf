@@ -0,0 +1,18 @@
+resource "vantage_kubernetes_integration" "prod" {
+  cluster_id = "prod-us-east-1"
+  workspaces = ["wrkspc_1234567890abcdef"]
+
+  agent_token_secret = {
+    name = "vantage-api-token"
+    key  = "token"
+  }
+}
+
+resource "helm_release" "vantage_agent" {
+  name       = "vka"
+  repository = "https://vantage-sh.github.io/helm-charts"
+  chart      = "vantage-kubernetes-agent"
+  namespace  = "vantage"
+
+  values = [vantage_kubernetes_integration.prod.helm_values.yaml]
+}
@@ -0,0 +1,10 @@
+variable "atlas_private_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "vantage_mongodb_atlas_integration" "main" {
+  organization_id = "5f1a2b3c4d5e6f7a8b9c0d1e"
+  public_key      = "abcdefgh"
+  private_key     = var.atlas_private_key
+}
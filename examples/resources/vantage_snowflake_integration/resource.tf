@@ -0,0 +1,6 @@
+resource "vantage_snowflake_integration" "main" {
+  account_identifier = "myorg-myaccount"
+  username           = "VANTAGE"
+  warehouse          = "VANTAGE_WH"
+  private_key        = file("${path.module}/vantage_rsa_key.p8")
+}
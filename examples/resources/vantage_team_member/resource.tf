@@ -0,0 +1,16 @@
+# The team leaves user_tokens and user_emails unset, so members added by
+# vantage_team_member (here or in other configurations) are not removed.
+resource "vantage_team" "platform" {
+  name             = "Platform"
+  workspace_tokens = ["wrkspc_47c3254c790e9351"]
+}
+
+resource "vantage_team_member" "alice" {
+  team_token = vantage_team.platform.token
+  user_email = "alice@example.com"
+}
+
+resource "vantage_team_member" "bob" {
+  team_token = vantage_team.platform.token
+  user_token = "usr_1234567890abcdef"
+}
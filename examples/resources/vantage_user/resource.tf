@@ -0,0 +1,6 @@
+resource "vantage_user" "new_engineer" {
+  email            = "new.engineer@example.com"
+  role             = "editor"
+  team_tokens      = ["team_1234567890abcdef"]
+  workspace_tokens = ["wrkspc_47c3254c790e9351"]
+}
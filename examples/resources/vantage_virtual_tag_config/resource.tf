@@ -33,3 +33,15 @@ resource "vantage_virtual_tag_config" "demo_virtual_tag_config" {
     # }
   ]
 }
+
+# Load a large account-to-team mapping from a CMDB export instead of listing
+# every value in HCL. teams.csv has the header "account_id,name".
+resource "vantage_virtual_tag_config" "team_from_cmdb" {
+  key            = "Team"
+  overridable    = false
+  backfill_until = "2024-01-01"
+  values_source = {
+    format  = "csv"
+    content = file("${path.module}/teams.csv")
+  }
+}
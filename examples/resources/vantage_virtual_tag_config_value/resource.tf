@@ -0,0 +1,23 @@
+resource "vantage_virtual_tag_config" "team" {
+  key            = "Team"
+  overridable    = false
+  backfill_until = "2024-01-01"
+  manage_values  = false
+}
+
+resource "vantage_virtual_tag_config_value" "platform" {
+  config_token = vantage_virtual_tag_config.team.token
+  name         = "Platform"
+  filter       = "(costs.provider = 'aws' AND tags.name = 'team' AND tags.value = 'platform')"
+  priority     = 1
+}
+
+resource "vantage_virtual_tag_config_value" "data" {
+  config_token = vantage_virtual_tag_config.team.token
+  name         = "Data"
+  filter       = "(costs.provider = 'gcp' AND costs.service = 'BigQuery')"
+  priority     = 2
+
+  # create the values in priority order
+  depends_on = [vantage_virtual_tag_config_value.platform]
+}
@@ -0,0 +1,377 @@
+package vantage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	accessgrantsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/access_grants"
+)
+
+var (
+	_ resource.Resource                = (*AccessGrantsResource)(nil)
+	_ resource.ResourceWithConfigure   = (*AccessGrantsResource)(nil)
+	_ resource.ResourceWithImportState = (*AccessGrantsResource)(nil)
+)
+
+type AccessGrantsResource struct {
+	client *Client
+}
+
+func NewAccessGrantsResource() resource.Resource {
+	return &AccessGrantsResource{}
+}
+
+var accessGrantsEntryAttrTypes = map[string]attr.Type{
+	"team_token": types.StringType,
+	"access":     types.StringType,
+}
+
+type AccessGrantsResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	ResourceToken types.String `tfsdk:"resource_token"`
+	Grants        types.Set    `tfsdk:"grants"`
+}
+
+type accessGrantsEntryModel struct {
+	TeamToken types.String `tfsdk:"team_token"`
+	Access    types.String `tfsdk:"access"`
+}
+
+func (r *AccessGrantsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_grants"
+}
+
+func (r *AccessGrantsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"resource_token": schema.StringAttribute{
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				MarkdownDescription: "Token of the shared object (e.g. a Cost Report or Dashboard) whose team access grants are managed.",
+			},
+			"grants": schema.SetNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The complete set of team access grants for the object. Grants for other teams, including ones created in the console, are deleted on apply.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"team_token": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Token of the team being granted.",
+						},
+						"access": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Access level of the grant. Must be either `allowed` or `denied`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("allowed", "denied"),
+							},
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "Same as resource_token.",
+			},
+		},
+		MarkdownDescription: "Authoritatively manages the team access grants of a shared object. Conflicts with `vantage_access_grant` resources for the same object.",
+	}
+}
+
+func (r *AccessGrantsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("resource_token"), req, resp)
+}
+
+func (r *AccessGrantsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccessGrantsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(ctx, &data, "Create Access Grants", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccessGrantsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AccessGrantsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := fetchAccessGrantsForResource(r.client, state.ResourceToken.ValueString())
+	if err != nil {
+		handleError("Read Access Grants", &resp.Diagnostics, err)
+		return
+	}
+
+	// Every team grant on the object is reported, so grants added out-of-band
+	// show up as drift and are removed on the next apply.
+	state.Id = state.ResourceToken
+	state.Grants = accessGrantsSetValue(current, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *AccessGrantsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccessGrantsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(ctx, &data, "Update Access Grants", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccessGrantsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AccessGrantsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed, d := accessGrantsFromSet(ctx, state.Grants)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := fetchAccessGrantsForResource(r.client, state.ResourceToken.ValueString())
+	if err != nil {
+		handleError("Delete Access Grants", &resp.Diagnostics, err)
+		return
+	}
+
+	// Only the grants this resource manages are removed; the object itself
+	// and grants that are not teams' are left alone.
+	for _, grant := range current {
+		if grant.TeamToken == nil {
+			continue
+		}
+		if _, ok := managed[*grant.TeamToken]; !ok {
+			continue
+		}
+		if !r.deleteGrant(grant.Token, "Delete Access Grants", &resp.Diagnostics) {
+			return
+		}
+	}
+}
+
+func (r *AccessGrantsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*Client)
+}
+
+// reconcile makes the object's team grants match data.Grants by creating,
+// updating and deleting individual grants, then stores the resulting grants.
+func (r *AccessGrantsResource) reconcile(ctx context.Context, data *AccessGrantsResourceModel, action string, diags *diag.Diagnostics) {
+	desired, d := accessGrantsFromSet(ctx, data.Grants)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	resourceToken := data.ResourceToken.ValueString()
+	current, err := fetchAccessGrantsForResource(r.client, resourceToken)
+	if err != nil {
+		handleError(action, diags, err)
+		return
+	}
+
+	changes := planAccessGrantChanges(current, desired)
+
+	for _, token := range changes.Delete {
+		if !r.deleteGrant(token, action, diags) {
+			return
+		}
+	}
+
+	for token, access := range changes.Update {
+		params := accessgrantsv2.NewUpdateAccessGrantParams()
+		params.WithAccessGrantToken(token)
+		params.WithUpdateAccessGrant(&modelsv2.UpdateAccessGrant{Access: &access})
+		if _, err := r.client.V2.AccessGrants.UpdateAccessGrant(params, r.client.Auth); err != nil {
+			handleError(action, diags, err)
+			return
+		}
+	}
+
+	for _, teamToken := range changes.Create {
+		teamToken := teamToken
+		params := accessgrantsv2.NewCreateAccessGrantParams()
+		params.WithCreateAccessGrant(&modelsv2.CreateAccessGrant{
+			ResourceToken: &resourceToken,
+			TeamToken:     &teamToken,
+			Access:        desired[teamToken],
+		})
+		if _, err := r.client.V2.AccessGrants.CreateAccessGrant(params, r.client.Auth); err != nil {
+			if e, ok := err.(*accessgrantsv2.CreateAccessGrantBadRequest); ok {
+				handleBadRequest(action, diags, e.GetPayload())
+				return
+			}
+			handleError(action, diags, err)
+			return
+		}
+	}
+
+	data.Id = data.ResourceToken
+}
+
+func (r *AccessGrantsResource) deleteGrant(token, action string, diags *diag.Diagnostics) bool {
+	params := accessgrantsv2.NewDeleteAccessGrantParams()
+	params.SetAccessGrantToken(token)
+	if _, err := r.client.V2.AccessGrants.DeleteAccessGrant(params, r.client.Auth); err != nil {
+		if _, ok := err.(*accessgrantsv2.DeleteAccessGrantNotFound); ok {
+			return true
+		}
+		handleError(action, diags, err)
+		return false
+	}
+	return true
+}
+
+// accessGrantChanges are the individual API calls needed to reconcile an
+// object's grants: team tokens to create, grant tokens to update with their
+// new access, and grant tokens to delete.
+type accessGrantChanges struct {
+	Create []string
+	Update map[string]string
+	Delete []string
+}
+
+// planAccessGrantChanges compares the object's current team grants with the
+// desired access per team token. Grants for teams that are not desired, and
+// any duplicate grants for the same team, are deleted. Create and Delete are
+// sorted so the calls are made in a stable order.
+func planAccessGrantChanges(current []*modelsv2.AccessGrant, desired map[string]string) accessGrantChanges {
+	changes := accessGrantChanges{Update: map[string]string{}}
+	seen := map[string]bool{}
+
+	for _, grant := range current {
+		if grant.TeamToken == nil {
+			continue
+		}
+		teamToken := *grant.TeamToken
+		access, ok := desired[teamToken]
+		if !ok || seen[teamToken] {
+			changes.Delete = append(changes.Delete, grant.Token)
+			continue
+		}
+		seen[teamToken] = true
+		if grant.Access != access {
+			changes.Update[grant.Token] = access
+		}
+	}
+
+	for teamToken := range desired {
+		if !seen[teamToken] {
+			changes.Create = append(changes.Create, teamToken)
+		}
+	}
+
+	sort.Strings(changes.Create)
+	sort.Strings(changes.Delete)
+	return changes
+}
+
+// accessGrantsFromSet converts the grants set into a map of team token to
+// access.
+func accessGrantsFromSet(ctx context.Context, grants types.Set) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := map[string]string{}
+	if grants.IsNull() || grants.IsUnknown() {
+		return out, diags
+	}
+
+	var entries []accessGrantsEntryModel
+	diags.Append(grants.ElementsAs(ctx, &entries, false)...)
+	for _, e := range entries {
+		teamToken := e.TeamToken.ValueString()
+		if _, dup := out[teamToken]; dup {
+			diags.AddAttributeError(
+				path.Root("grants"),
+				"Duplicate Team Grant",
+				fmt.Sprintf("Team %s is listed more than once; each team can only have one grant per object.", teamToken),
+			)
+			continue
+		}
+		out[teamToken] = e.Access.ValueString()
+	}
+	return out, diags
+}
+
+// accessGrantsSetValue builds the grants set from the object's current team
+// grants.
+func accessGrantsSetValue(grants []*modelsv2.AccessGrant, diags *diag.Diagnostics) types.Set {
+	entryType := types.ObjectType{AttrTypes: accessGrantsEntryAttrTypes}
+	elems := make([]attr.Value, 0, len(grants))
+	for _, grant := range grants {
+		if grant.TeamToken == nil {
+			continue
+		}
+		obj, d := types.ObjectValue(accessGrantsEntryAttrTypes, map[string]attr.Value{
+			"team_token": types.StringValue(*grant.TeamToken),
+			"access":     types.StringValue(grant.Access),
+		})
+		diags.Append(d...)
+		elems = append(elems, obj)
+	}
+	set, d := types.SetValue(entryType, elems)
+	diags.Append(d...)
+	return set
+}
+
+// fetchAccessGrantsForResource returns the grants on resourceToken. The Get
+// All Access Grants endpoint has no resource filter, so grants are matched
+// client-side.
+func fetchAccessGrantsForResource(client *Client, resourceToken string) ([]*modelsv2.AccessGrant, error) {
+	grants, err := fetchAllPages(func(limit int32, page *int32) ([]*modelsv2.AccessGrant, *modelsv2.Links, error) {
+		params := accessgrantsv2.NewGetAccessGrantsParams()
+		params.SetLimit(&limit)
+		params.SetPage(page)
+		out, err := client.V2.AccessGrants.GetAccessGrants(params, client.Auth)
+		if err != nil {
+			return nil, nil, err
+		}
+		return out.Payload.AccessGrants, out.Payload.Links, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []*modelsv2.AccessGrant
+	for _, grant := range grants {
+		if grant.ResourceToken == resourceToken {
+			matched = append(matched, grant)
+		}
+	}
+	return matched, nil
+}
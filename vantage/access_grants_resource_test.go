@@ -0,0 +1,56 @@
+package vantage
+
+import (
+	"reflect"
+	"testing"
+
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+)
+
+func testAccessGrant(token, teamToken, access string) *modelsv2.AccessGrant {
+	return &modelsv2.AccessGrant{
+		Token:         token,
+		ResourceToken: "rprt_1",
+		TeamToken:     &teamToken,
+		Access:        access,
+	}
+}
+
+func TestPlanAccessGrantChanges(t *testing.T) {
+	current := []*modelsv2.AccessGrant{
+		testAccessGrant("acs_keep", "team_keep", "allowed"),
+		testAccessGrant("acs_flip", "team_flip", "allowed"),
+		testAccessGrant("acs_oob", "team_oob", "allowed"),
+		testAccessGrant("acs_dup", "team_keep", "allowed"),
+		{Token: "acs_noteam", ResourceToken: "rprt_1", Access: "allowed"},
+	}
+	desired := map[string]string{
+		"team_keep": "allowed",
+		"team_flip": "denied",
+		"team_new":  "allowed",
+	}
+
+	got := planAccessGrantChanges(current, desired)
+
+	want := accessGrantChanges{
+		Create: []string{"team_new"},
+		Update: map[string]string{"acs_flip": "denied"},
+		Delete: []string{"acs_dup", "acs_oob"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("planAccessGrantChanges() = %+v, want %+v", got, want)
+	}
+}
+
+func TestPlanAccessGrantChanges_noop(t *testing.T) {
+	current := []*modelsv2.AccessGrant{
+		testAccessGrant("acs_1", "team_1", "allowed"),
+		testAccessGrant("acs_2", "team_2", "denied"),
+	}
+	desired := map[string]string{"team_1": "allowed", "team_2": "denied"}
+
+	got := planAccessGrantChanges(current, desired)
+	if len(got.Create) != 0 || len(got.Update) != 0 || len(got.Delete) != 0 {
+		t.Errorf("expected no changes, got %+v", got)
+	}
+}
@@ -0,0 +1,217 @@
+package vantage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	anomalyalertsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/anomaly_alerts"
+)
+
+var (
+	_ resource.Resource                = (*AnomalyAlertResource)(nil)
+	_ resource.ResourceWithConfigure   = (*AnomalyAlertResource)(nil)
+	_ resource.ResourceWithImportState = (*AnomalyAlertResource)(nil)
+)
+
+type AnomalyAlertResource struct {
+	client *Client
+}
+
+func NewAnomalyAlertResource() resource.Resource {
+	return &AnomalyAlertResource{}
+}
+
+type AnomalyAlertResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	AnomalyAlertToken types.String `tfsdk:"anomaly_alert_token"`
+	Status            types.String `tfsdk:"status"`
+	Feedback          types.String `tfsdk:"feedback"`
+	CostReportToken   types.String `tfsdk:"cost_report_token"`
+	Provider          types.String `tfsdk:"provider"`
+	Service           types.String `tfsdk:"service"`
+	Amount            types.String `tfsdk:"amount"`
+}
+
+func (r *AnomalyAlertResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_anomaly_alert"
+}
+
+func (r *AnomalyAlertResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"anomaly_alert_token": schema.StringAttribute{
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				MarkdownDescription: "Token of the existing anomaly alert to manage.",
+			},
+			"status": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Status of the anomaly alert. Must be either `active` or `archived`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("active", "archived"),
+				},
+			},
+			"feedback": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "Feedback to record on the anomaly alert. When unset, any feedback already on the alert, e.g. from the console, is kept.",
+			},
+			"cost_report_token": schema.StringAttribute{
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "Token of the Cost Report the anomaly was detected on.",
+			},
+			"provider": schema.StringAttribute{
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "Provider of the anomalous costs.",
+			},
+			"service": schema.StringAttribute{
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "Service of the anomalous costs.",
+			},
+			"amount": schema.StringAttribute{
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "Amount of the anomalous costs.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "Same as anomaly_alert_token.",
+			},
+		},
+		MarkdownDescription: "Manages the status and feedback of an existing anomaly alert. Anomaly alerts are created by Vantage and cannot be deleted; destroying this resource only removes it from Terraform state.",
+	}
+}
+
+func (r *AnomalyAlertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("anomaly_alert_token"), req, resp)
+}
+
+func (r *AnomalyAlertResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AnomalyAlertResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.update(&data)
+	if err != nil {
+		if e, ok := err.(*anomalyalertsv2.UpdateAnomalyAlertBadRequest); ok {
+			handleBadRequest("Update Anomaly Alert", &resp.Diagnostics, e.GetPayload())
+			return
+		}
+		handleError("Update Anomaly Alert", &resp.Diagnostics, err)
+		return
+	}
+
+	applyAnomalyAlertPayload(out, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AnomalyAlertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AnomalyAlertResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := anomalyalertsv2.NewGetAnomalyAlertParams()
+	params.SetAnomalyAlertToken(state.AnomalyAlertToken.ValueString())
+	out, err := r.client.V2.AnomalyAlerts.GetAnomalyAlert(params, r.client.Auth)
+	if err != nil {
+		if _, ok := err.(*anomalyalertsv2.GetAnomalyAlertNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		handleError("Read Anomaly Alert", &resp.Diagnostics, err)
+		return
+	}
+
+	applyAnomalyAlertPayload(out.Payload, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *AnomalyAlertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AnomalyAlertResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.update(&data)
+	if err != nil {
+		if e, ok := err.(*anomalyalertsv2.UpdateAnomalyAlertBadRequest); ok {
+			handleBadRequest("Update Anomaly Alert", &resp.Diagnostics, e.GetPayload())
+			return
+		}
+		handleError("Update Anomaly Alert", &resp.Diagnostics, err)
+		return
+	}
+
+	applyAnomalyAlertPayload(out, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AnomalyAlertResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Anomaly alerts are owned by Vantage and there is no delete endpoint.
+	// The alert keeps its last status; it is only removed from state.
+}
+
+// update sends the configured status and feedback to the Update Anomaly Alert
+// endpoint and returns the updated alert.
+func (r *AnomalyAlertResource) update(data *AnomalyAlertResourceModel) (*modelsv2.AnomalyAlert, error) {
+	params := anomalyalertsv2.NewUpdateAnomalyAlertParams()
+	params.SetAnomalyAlertToken(data.AnomalyAlertToken.ValueString())
+	body := &modelsv2.UpdateAnomalyAlert{
+		Status: data.Status.ValueStringPointer(),
+	}
+	if !data.Feedback.IsNull() && !data.Feedback.IsUnknown() {
+		body.Feedback = data.Feedback.ValueString()
+	}
+	params.WithUpdateAnomalyAlert(body)
+
+	out, err := r.client.V2.AnomalyAlerts.UpdateAnomalyAlert(params, r.client.Auth)
+	if err != nil {
+		return nil, err
+	}
+	return out.Payload, nil
+}
+
+func applyAnomalyAlertPayload(payload *modelsv2.AnomalyAlert, data *AnomalyAlertResourceModel) {
+	data.Id = types.StringValue(payload.Token)
+	data.AnomalyAlertToken = types.StringValue(payload.Token)
+	data.Status = types.StringValue(payload.Status)
+	// The API reports missing feedback as an empty string, which is kept only
+	// when it is what was configured.
+	switch {
+	case payload.Feedback != nil && *payload.Feedback != "":
+		data.Feedback = types.StringValue(*payload.Feedback)
+	case data.Feedback.IsUnknown() || data.Feedback.ValueString() != "":
+		data.Feedback = types.StringNull()
+	}
+	data.CostReportToken = types.StringValue(payload.CostReportToken)
+	data.Provider = types.StringValue(payload.Provider)
+	data.Service = types.StringValue(payload.Service)
+	data.Amount = types.StringValue(payload.Amount)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *AnomalyAlertResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*Client)
+}
@@ -0,0 +1,31 @@
+package vantage
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+)
+
+func TestApplyAnomalyAlertPayloadFeedback(t *testing.T) {
+	feedback := func(s string) *string { return &s }
+	for _, tc := range []struct {
+		name    string
+		prior   types.String
+		payload *string
+		want    types.String
+	}{
+		{"unset in config, set in console", types.StringUnknown(), feedback("Known spike."), types.StringValue("Known spike.")},
+		{"unset everywhere", types.StringUnknown(), feedback(""), types.StringNull()},
+		{"cleared in console", types.StringValue("Known spike."), nil, types.StringNull()},
+		{"configured", types.StringValue("Load test."), feedback("Load test."), types.StringValue("Load test.")},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data := AnomalyAlertResourceModel{Feedback: tc.prior}
+			applyAnomalyAlertPayload(&modelsv2.AnomalyAlert{Token: "anmly_lrt_1", Feedback: tc.payload}, &data)
+			if !data.Feedback.Equal(tc.want) {
+				t.Errorf("got feedback %v, want %v", data.Feedback, tc.want)
+			}
+		})
+	}
+}
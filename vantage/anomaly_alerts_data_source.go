@@ -0,0 +1,244 @@
+package vantage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	anomalyalertsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/anomaly_alerts"
+)
+
+var (
+	_ datasource.DataSource              = (*anomalyAlertsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*anomalyAlertsDataSource)(nil)
+)
+
+func NewAnomalyAlertsDataSource() datasource.DataSource {
+	return &anomalyAlertsDataSource{}
+}
+
+type anomalyAlertItemModel struct {
+	Token           types.String `tfsdk:"token"`
+	CostReportToken types.String `tfsdk:"cost_report_token"`
+	Provider        types.String `tfsdk:"provider"`
+	Service         types.String `tfsdk:"service"`
+	Category        types.String `tfsdk:"category"`
+	Amount          types.String `tfsdk:"amount"`
+	PreviousAmount  types.String `tfsdk:"previous_amount"`
+	SevenDayAverage types.String `tfsdk:"seven_day_average"`
+	Status          types.String `tfsdk:"status"`
+	Feedback        types.String `tfsdk:"feedback"`
+	Resources       types.List   `tfsdk:"resources"`
+	AlertedAt       types.String `tfsdk:"alerted_at"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+}
+
+type anomalyAlertsDataSourceModel struct {
+	CostReportToken types.String            `tfsdk:"cost_report_token"`
+	ProviderFilter  types.String            `tfsdk:"provider_filter"`
+	Service         types.String            `tfsdk:"service"`
+	Status          types.String            `tfsdk:"status"`
+	StartDate       types.String            `tfsdk:"start_date"`
+	EndDate         types.String            `tfsdk:"end_date"`
+	AnomalyAlerts   []anomalyAlertItemModel `tfsdk:"anomaly_alerts"`
+}
+
+// anomalyAlertsFilter holds the query parameters accepted by the Get All
+// Anomaly Alerts endpoint. Nil fields are omitted from the request.
+type anomalyAlertsFilter struct {
+	Provider  *string
+	Service   *string
+	StartDate *string
+	EndDate   *string
+}
+
+type anomalyAlertsDataSource struct {
+	client *Client
+}
+
+func (d *anomalyAlertsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*Client)
+}
+
+func (d *anomalyAlertsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_anomaly_alerts"
+}
+
+func (d *anomalyAlertsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	itemAttrs := map[string]schema.Attribute{
+		"token": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The unique token of the anomaly alert.",
+		},
+		"cost_report_token": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The token of the Cost Report the anomaly was detected on.",
+		},
+		"provider": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The provider of the anomalous costs (e.g. `aws`).",
+		},
+		"service": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The service of the anomalous costs (e.g. `Amazon Elastic Compute Cloud - Compute`).",
+		},
+		"category": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The cost category of the anomalous costs.",
+		},
+		"amount": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The amount of the anomalous costs.",
+		},
+		"previous_amount": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The amount of costs for the same period before the anomaly.",
+		},
+		"seven_day_average": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The seven day average of costs before the anomaly.",
+		},
+		"status": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The status of the anomaly alert (e.g. `active`, `archived`).",
+		},
+		"feedback": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "User-provided feedback on the anomaly alert. Null if no feedback was given.",
+		},
+		"resources": schema.ListAttribute{
+			Computed:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "The provider resource ids that contributed to the anomaly.",
+		},
+		"alerted_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The date and time (UTC, ISO 8601) the anomaly was alerted on. Null if the anomaly has not been alerted.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The date and time (UTC, ISO 8601) when the anomaly alert was created.",
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns anomaly alerts detected on the Cost Reports visible to the API token, optionally filtered by cost report, provider, service, status and date range.",
+		Attributes: map[string]schema.Attribute{
+			"cost_report_token": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return anomaly alerts detected on this Cost Report.",
+			},
+			"provider_filter": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Filter results by provider (e.g. `aws`). Corresponds to the `provider` query parameter on the Get All Anomaly Alerts API endpoint.",
+			},
+			"service": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Filter results by service (e.g. `Amazon Elastic Compute Cloud - Compute`).",
+			},
+			"status": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return anomaly alerts with this status (e.g. `active`, `archived`).",
+			},
+			"start_date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return anomaly alerts created on or after this date (ISO 8601, e.g. `2024-01-01`).",
+			},
+			"end_date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return anomaly alerts created on or before this date (ISO 8601, e.g. `2024-01-31`).",
+			},
+			"anomaly_alerts": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The list of anomaly alerts returned by the API.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: itemAttrs,
+				},
+			},
+		},
+	}
+}
+
+func (d *anomalyAlertsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state anomalyAlertsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := anomalyAlertsFilter{
+		Provider:  state.ProviderFilter.ValueStringPointer(),
+		Service:   state.Service.ValueStringPointer(),
+		StartDate: state.StartDate.ValueStringPointer(),
+		EndDate:   state.EndDate.ValueStringPointer(),
+	}
+
+	allAlerts, err := fetchAllAnomalyAlerts(d.client, filter)
+	if err != nil {
+		handleError("Read Anomaly Alerts", &resp.Diagnostics, err)
+		return
+	}
+
+	// cost_report_token and status are not supported as query parameters by
+	// the API, so they are applied to the fetched results instead.
+	filterByReport := !state.CostReportToken.IsNull() && !state.CostReportToken.IsUnknown()
+	filterByStatus := !state.Status.IsNull() && !state.Status.IsUnknown()
+
+	state.AnomalyAlerts = make([]anomalyAlertItemModel, 0, len(allAlerts))
+	for _, alert := range allAlerts {
+		if filterByReport && alert.CostReportToken != state.CostReportToken.ValueString() {
+			continue
+		}
+		if filterByStatus && alert.Status != state.Status.ValueString() {
+			continue
+		}
+
+		resources, diags := types.ListValueFrom(ctx, types.StringType, alert.Resources)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.AnomalyAlerts = append(state.AnomalyAlerts, anomalyAlertItemModel{
+			Token:           types.StringValue(alert.Token),
+			CostReportToken: types.StringValue(alert.CostReportToken),
+			Provider:        types.StringValue(alert.Provider),
+			Service:         types.StringValue(alert.Service),
+			Category:        types.StringPointerValue(alert.Category),
+			Amount:          types.StringValue(alert.Amount),
+			PreviousAmount:  types.StringValue(alert.PreviousAmount),
+			SevenDayAverage: types.StringValue(alert.SevenDayAverage),
+			Status:          types.StringValue(alert.Status),
+			Feedback:        types.StringPointerValue(alert.Feedback),
+			Resources:       resources,
+			AlertedAt:       types.StringPointerValue(alert.AlertedAt),
+			CreatedAt:       types.StringValue(alert.CreatedAt),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// fetchAllAnomalyAlerts returns every anomaly alert matching filter, across
+// all pages of the Get All Anomaly Alerts endpoint.
+func fetchAllAnomalyAlerts(client *Client, filter anomalyAlertsFilter) ([]*modelsv2.AnomalyAlert, error) {
+	return fetchAllPages(func(limit int32, page *int32) ([]*modelsv2.AnomalyAlert, *modelsv2.Links, error) {
+		params := anomalyalertsv2.NewGetAnomalyAlertsParams()
+		params.SetLimit(&limit)
+		params.SetProvider(filter.Provider)
+		params.SetService(filter.Service)
+		params.SetStartDate(filter.StartDate)
+		params.SetEndDate(filter.EndDate)
+		params.SetPage(page)
+		out, err := client.V2.AnomalyAlerts.GetAnomalyAlerts(params, client.Auth)
+		if err != nil {
+			return nil, nil, err
+		}
+		return out.Payload.AnomalyAlerts, out.Payload.Links, nil
+	})
+}
@@ -0,0 +1,164 @@
+package vantage
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+)
+
+// ---------------------------------------------------------------------------
+// fetchAllAnomalyAlerts unit tests — driven by a mock HTTP server
+// ---------------------------------------------------------------------------
+
+func mockAnomalyAlert(token, costReportToken, status string) *modelsv2.AnomalyAlert {
+	return &modelsv2.AnomalyAlert{
+		Token:           token,
+		CostReportToken: costReportToken,
+		Provider:        "aws",
+		Service:         "Amazon Elastic Compute Cloud - Compute",
+		Amount:          "120.00",
+		PreviousAmount:  "20.00",
+		SevenDayAverage: "22.50",
+		Status:          status,
+		Resources:       []string{},
+		CreatedAt:       "2024-01-01T00:00:00Z",
+	}
+}
+
+type anomalyAlertsResponse struct {
+	AnomalyAlerts []*modelsv2.AnomalyAlert `json:"anomaly_alerts"`
+	Links         *modelsv2.Links          `json:"links,omitempty"`
+}
+
+func newMockAnomalyAlertsServer(t *testing.T, pages [][]*modelsv2.AnomalyAlert) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/anomaly_alerts" {
+			http.NotFound(w, r)
+			return
+		}
+
+		pageStr := r.URL.Query().Get("page")
+		pageNum := 1
+		if pageStr != "" {
+			fmt.Sscanf(pageStr, "%d", &pageNum)
+		}
+
+		idx := pageNum - 1
+		if idx < 0 || idx >= len(pages) {
+			http.Error(w, "page out of range", http.StatusBadRequest)
+			return
+		}
+
+		var links *modelsv2.Links
+		if pageNum < len(pages) {
+			next := fmt.Sprintf("http://%s/v2/anomaly_alerts?limit=1000&page=%d", r.Host, pageNum+1)
+			links = &modelsv2.Links{Next: &next}
+		}
+
+		resp := anomalyAlertsResponse{
+			AnomalyAlerts: pages[idx],
+			Links:         links,
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	return srv
+}
+
+func TestFetchAllAnomalyAlerts_multiplePages(t *testing.T) {
+	page1 := []*modelsv2.AnomalyAlert{mockAnomalyAlert("anmly_1", "rprt_a", "active")}
+	page2 := []*modelsv2.AnomalyAlert{
+		mockAnomalyAlert("anmly_2", "rprt_a", "archived"),
+		mockAnomalyAlert("anmly_3", "rprt_b", "active"),
+	}
+
+	srv := newMockAnomalyAlertsServer(t, [][]*modelsv2.AnomalyAlert{page1, page2})
+	defer srv.Close()
+
+	got, err := fetchAllAnomalyAlerts(clientForServer(t, srv.URL), anomalyAlertsFilter{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"anmly_1", "anmly_2", "anmly_3"}
+	if len(got) != len(want) {
+		t.Fatalf("got %d anomaly alerts, want %d", len(got), len(want))
+	}
+	for i, tok := range want {
+		if got[i].Token != tok {
+			t.Errorf("index %d: got token %q, want %q", i, got[i].Token, tok)
+		}
+	}
+}
+
+func TestFetchAllAnomalyAlerts_emptyResult(t *testing.T) {
+	srv := newMockAnomalyAlertsServer(t, [][]*modelsv2.AnomalyAlert{{}})
+	defer srv.Close()
+
+	got, err := fetchAllAnomalyAlerts(clientForServer(t, srv.URL), anomalyAlertsFilter{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("got %d anomaly alerts, want 0", len(got))
+	}
+}
+
+func TestFetchAllAnomalyAlerts_forwardsFilters(t *testing.T) {
+	var captured map[string]string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		captured = map[string]string{
+			"provider":   q.Get("provider"),
+			"service":    q.Get("service"),
+			"start_date": q.Get("start_date"),
+			"end_date":   q.Get("end_date"),
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(anomalyAlertsResponse{AnomalyAlerts: []*modelsv2.AnomalyAlert{}})
+	}))
+	defer srv.Close()
+
+	provider := "aws"
+	service := "Amazon Simple Storage Service"
+	startDate := "2024-01-01"
+	endDate := "2024-01-31"
+	_, err := fetchAllAnomalyAlerts(clientForServer(t, srv.URL), anomalyAlertsFilter{
+		Provider:  &provider,
+		Service:   &service,
+		StartDate: &startDate,
+		EndDate:   &endDate,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]string{
+		"provider":   provider,
+		"service":    service,
+		"start_date": startDate,
+		"end_date":   endDate,
+	}
+	for k, v := range want {
+		if captured[k] != v {
+			t.Errorf("query parameter %s not forwarded: got %q, want %q", k, captured[k], v)
+		}
+	}
+}
+
+func TestFetchAllAnomalyAlerts_apiError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	_, err := fetchAllAnomalyAlerts(clientForServer(t, srv.URL), anomalyAlertsFilter{})
+	if err == nil {
+		t.Fatal("expected error from API, got nil")
+	}
+}
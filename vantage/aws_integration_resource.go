@@ -0,0 +1,347 @@
+package vantage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vantage-sh/terraform-provider-vantage/vantage/planmodifiers"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	integrationsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/integrations"
+)
+
+var (
+	_ resource.Resource                = (*AwsIntegrationResource)(nil)
+	_ resource.ResourceWithConfigure   = (*AwsIntegrationResource)(nil)
+	_ resource.ResourceWithImportState = (*AwsIntegrationResource)(nil)
+	_ resource.ResourceWithMoveState   = (*AwsIntegrationResource)(nil)
+)
+
+type AwsIntegrationResource struct{ client *Client }
+
+func NewAwsIntegrationResource() resource.Resource { return &AwsIntegrationResource{} }
+
+type AwsIntegrationResourceModel struct {
+	CrossAccountARN      types.String `tfsdk:"cross_account_arn"`
+	BucketARN            types.String `tfsdk:"bucket_arn"`
+	Workspaces           types.Set    `tfsdk:"workspaces"`
+	ManagedAccountTokens types.Set    `tfsdk:"managed_account_tokens"`
+	AccountIdentifier    types.String `tfsdk:"account_identifier"`
+	Status               types.String `tfsdk:"status"`
+	Token                types.String `tfsdk:"token"`
+	Id                   types.String `tfsdk:"id"`
+}
+
+func (r *AwsIntegrationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *AwsIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_aws_integration"
+}
+
+func (r *AwsIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cross_account_arn": schema.StringAttribute{
+				Required: true,
+				// The integrations API has no endpoint to change the role of an
+				// existing integration.
+				PlanModifiers:       []planmodifier.String{planmodifiers.ImmutableAfterCreate("cross_account_arn")},
+				MarkdownDescription: "ARN of the IAM role Vantage assumes for cross account access. See the `vantage_aws_provider_info` data source for the role setup. Cannot be changed after creation.",
+			},
+			"bucket_arn": schema.StringAttribute{
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{planmodifiers.ImmutableAfterCreate("bucket_arn")},
+				MarkdownDescription: "ARN of the S3 bucket the Cost and Usage Report (CUR) is delivered to. Omit for member accounts whose costs are imported through the management account. Cannot be changed after creation.",
+			},
+			"workspaces": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers:       []planmodifier.Set{planmodifiers.UseStateWhenEmpty()},
+				MarkdownDescription: "Workspace tokens to associate with the integration. Can be updated in-place. Note: the Vantage API requires at least one token — workspace associations cannot be fully removed once set.",
+			},
+			"managed_account_tokens": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers:       []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "The tokens of any Managed Accounts associated with this integration.",
+			},
+			"account_identifier": schema.StringAttribute{
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "The AWS account id of the integration, as reported by the API.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the integration (e.g. connected, pending, importing, imported, error, disconnected). This is system-managed and cannot be configured.",
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "Unique token of the AWS integration.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "Same as token.",
+			},
+		},
+		MarkdownDescription: "Manages an AWS integration through the v2 integrations API. Replaces `vantage_aws_provider`; existing `vantage_aws_provider` resources can be migrated with a `moved` block.",
+	}
+}
+
+func (r *AwsIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("token"), req, resp)
+}
+
+func (r *AwsIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AwsIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := &modelsv2.CreateAWSIntegration{
+		CrossAccountArn: data.CrossAccountARN.ValueStringPointer(),
+	}
+	if !data.BucketARN.IsNull() && !data.BucketARN.IsUnknown() {
+		payload.BucketArn = data.BucketARN.ValueString()
+	}
+
+	params := integrationsv2.NewCreateAWSIntegrationParams()
+	params.WithCreateAWSIntegration(payload)
+
+	out, err := r.client.V2.Integrations.CreateAWSIntegration(params, r.client.Auth)
+	if err != nil {
+		if e, ok := err.(*integrationsv2.CreateAWSIntegrationBadRequest); ok {
+			handleBadRequest("Create AWS Integration", &resp.Diagnostics, e.GetPayload())
+			return
+		}
+		handleError("Create AWS Integration", &resp.Diagnostics, err)
+		return
+	}
+
+	planned := data.Workspaces
+	resp.Diagnostics.Append(data.applyPayload(ctx, out.Payload)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Associate workspaces immediately after creation if specified;
+	// otherwise keep the workspaces the API assigned by default.
+	if !planned.IsNull() && !planned.IsUnknown() {
+		data.Workspaces = applyIntegrationWorkspaces(ctx, r.client, "Update AWS Integration Workspaces", out.Payload.Token, planned, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			// The integration exists: persist it so that it is tainted
+			// rather than orphaned.
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AwsIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AwsIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := integrationsv2.NewGetIntegrationParams()
+	params.SetIntegrationToken(state.Token.ValueString())
+
+	out, err := r.client.V2.Integrations.GetIntegration(params, r.client.Auth)
+	if err != nil {
+		if _, ok := err.(*integrationsv2.GetIntegrationNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		handleError("Read AWS Integration", &resp.Diagnostics, err)
+		return
+	}
+
+	// cross_account_arn and bucket_arn are not returned by the generic GET
+	// endpoint, so whatever is already in state is preserved.
+	resp.Diagnostics.Append(state.applyPayload(ctx, out.Payload)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *AwsIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// cross_account_arn and bucket_arn are guarded by ImmutableAfterCreate plan
+	// modifiers, so only workspace changes reach the API. After an import they
+	// are null in state and are seeded from config here.
+	var plan AwsIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state AwsIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Token = state.Token
+	plan.Id = state.Id
+	plan.Status = state.Status
+	plan.AccountIdentifier = state.AccountIdentifier
+	plan.ManagedAccountTokens = state.ManagedAccountTokens
+
+	plan.Workspaces = applyIntegrationWorkspaces(ctx, r.client, "Update AWS Integration Workspaces", state.Token.ValueString(), plan.Workspaces, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AwsIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AwsIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := integrationsv2.NewDeleteIntegrationParams()
+	params.SetIntegrationToken(state.Token.ValueString())
+
+	_, err := r.client.V2.Integrations.DeleteIntegration(params, r.client.Auth)
+	if err != nil {
+		if _, ok := err.(*integrationsv2.DeleteIntegrationNotFound); ok {
+			return
+		}
+		handleError("Delete AWS Integration", &resp.Diagnostics, err)
+	}
+}
+
+// MoveState migrates vantage_aws_provider resources to vantage_aws_integration
+// via a `moved` block. vantage_aws_provider stores the V1 access credential id,
+// which has no V2 equivalent, so the integration is located by matching the
+// AWS account id in cross_account_arn against the v2 AWS integrations.
+func (r *AwsIntegrationResource) MoveState(_ context.Context) []resource.StateMover {
+	sourceSchema := awsProviderResourceSchema()
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &sourceSchema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "vantage_aws_provider" {
+					return
+				}
+				if !strings.HasSuffix(req.SourceProviderAddress, "vantage-sh/vantage") {
+					return
+				}
+
+				var source AwsProviderResourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				integration, err := r.findIntegrationForRole(source.CrossAccountARN.ValueString())
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to Move vantage_aws_provider State",
+						fmt.Sprintf("Could not find the v2 integration for vantage_aws_provider %d: %s\n\n"+
+							"Remove the moved block and import the integration instead: terraform import vantage_aws_integration.<name> <integration token>",
+							source.Id.ValueInt64(), err),
+					)
+					return
+				}
+
+				target := AwsIntegrationResourceModel{
+					CrossAccountARN: source.CrossAccountARN,
+					BucketARN:       source.BucketARN,
+				}
+				resp.Diagnostics.Append(target.applyPayload(ctx, integration)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &target)...)
+			},
+		},
+	}
+}
+
+// findIntegrationForRole returns the single AWS integration whose account
+// identifier matches the account id embedded in crossAccountARN.
+func (r *AwsIntegrationResource) findIntegrationForRole(crossAccountARN string) (*modelsv2.Integration, error) {
+	accountID, err := awsAccountIDFromARN(crossAccountARN)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := "aws"
+	integrations, err := fetchAllIntegrations(r.client, &provider)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*modelsv2.Integration
+	for _, integration := range integrations {
+		if integration.AccountIdentifier != nil && *integration.AccountIdentifier == accountID {
+			matches = append(matches, integration)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no AWS integration found for account %s", accountID)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("%d AWS integrations found for account %s", len(matches), accountID)
+	}
+}
+
+// awsAccountIDFromARN returns the account id field of an IAM ARN such as
+// arn:aws:iam::123456789012:role/vantage.
+func awsAccountIDFromARN(arn string) (string, error) {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) != 6 || parts[0] != "arn" || parts[4] == "" {
+		return "", fmt.Errorf("%q is not a valid IAM role ARN", arn)
+	}
+	return parts[4], nil
+}
+
+// applyPayload copies the API-managed fields of an integration into the model.
+// cross_account_arn and bucket_arn are left untouched.
+func (m *AwsIntegrationResourceModel) applyPayload(ctx context.Context, payload *modelsv2.Integration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Token = types.StringValue(payload.Token)
+	m.Id = types.StringValue(payload.Token)
+	m.Status = types.StringValue(payload.Status)
+	m.AccountIdentifier = types.StringPointerValue(payload.AccountIdentifier)
+
+	workspaces, d := types.SetValueFrom(ctx, types.StringType, payload.WorkspaceTokens)
+	diags.Append(d...)
+	m.Workspaces = workspaces
+
+	managedAccountTokens, d := types.SetValueFrom(ctx, types.StringType, payload.ManagedAccountTokens)
+	diags.Append(d...)
+	m.ManagedAccountTokens = managedAccountTokens
+
+	return diags
+}
@@ -0,0 +1,84 @@
+package vantage
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+)
+
+func TestAwsAccountIDFromARN(t *testing.T) {
+	got, err := awsAccountIDFromARN("arn:aws:iam::123456789012:role/vantage-cross-account")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "123456789012" {
+		t.Errorf("got %q, want %q", got, "123456789012")
+	}
+
+	for _, arn := range []string{"", "123456789012", "arn:aws:iam:::role/vantage", "urn:aws:iam::123456789012:role/vantage"} {
+		if _, err := awsAccountIDFromARN(arn); err == nil {
+			t.Errorf("expected error for %q, got nil", arn)
+		}
+	}
+}
+
+func TestAwsIntegrationFindIntegrationForRole(t *testing.T) {
+	pages := [][]*modelsv2.Integration{
+		{mockIntegration("accss_crdntl_1", "111111111111")},
+		{mockIntegration("accss_crdntl_2", "123456789012"), mockIntegration("accss_crdntl_3", "222222222222")},
+	}
+	srv := newMockIntegrationsServer(t, pages)
+	defer srv.Close()
+
+	r := &AwsIntegrationResource{client: clientForServer(t, srv.URL)}
+
+	got, err := r.findIntegrationForRole("arn:aws:iam::123456789012:role/vantage")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Token != "accss_crdntl_2" {
+		t.Errorf("got token %q, want %q", got.Token, "accss_crdntl_2")
+	}
+
+	_, err = r.findIntegrationForRole("arn:aws:iam::999999999999:role/vantage")
+	if err == nil || !strings.Contains(err.Error(), "no AWS integration found") {
+		t.Errorf("expected not-found error, got %v", err)
+	}
+}
+
+func TestAwsIntegrationFindIntegrationForRole_ambiguous(t *testing.T) {
+	pages := [][]*modelsv2.Integration{
+		{mockIntegration("accss_crdntl_1", "123456789012"), mockIntegration("accss_crdntl_2", "123456789012")},
+	}
+	srv := newMockIntegrationsServer(t, pages)
+	defer srv.Close()
+
+	r := &AwsIntegrationResource{client: clientForServer(t, srv.URL)}
+
+	_, err := r.findIntegrationForRole("arn:aws:iam::123456789012:role/vantage")
+	if err == nil || !strings.Contains(err.Error(), "2 AWS integrations found") {
+		t.Errorf("expected ambiguity error, got %v", err)
+	}
+}
+
+func TestAwsIntegrationApplyPayloadWorkspaces(t *testing.T) {
+	integration := mockIntegration("accss_crdntl_1", "123456789012")
+	integration.WorkspaceTokens = []string{"wrkspc_1", "wrkspc_2"}
+
+	var m AwsIntegrationResourceModel
+	if diags := m.applyPayload(context.Background(), integration); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var workspaces []string
+	if diags := m.Workspaces.ElementsAs(context.Background(), &workspaces, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	slices.Sort(workspaces)
+	if !slices.Equal(workspaces, []string{"wrkspc_1", "wrkspc_2"}) {
+		t.Errorf("got workspaces %v, want [wrkspc_1 wrkspc_2]", workspaces)
+	}
+}
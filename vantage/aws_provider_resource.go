@@ -31,7 +31,13 @@ func (r *AwsProviderResource) Metadata(_ context.Context, req resource.MetadataR
 }
 
 func (r AwsProviderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
+	resp.Schema = awsProviderResourceSchema()
+}
+
+// awsProviderResourceSchema is shared with AwsIntegrationResource, which uses
+// it as the source schema when moving state from vantage_aws_provider.
+func awsProviderResourceSchema() schema.Schema {
+	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"cross_account_arn": schema.StringAttribute{
 				MarkdownDescription: "ARN to use for cross account access.",
@@ -49,7 +55,8 @@ func (r AwsProviderResource) Schema(ctx context.Context, req resource.SchemaRequ
 				//},
 			},
 		},
-		MarkdownDescription: "Manages an AWS Account Integration.",
+		MarkdownDescription: "Manages an AWS Account Integration. Deprecated: use `vantage_aws_integration`, which supports import and workspace assignment. Existing resources can be migrated with a `moved` block.",
+		DeprecationMessage:  "vantage_aws_provider is deprecated and will be removed in a future release. Use vantage_aws_integration instead; existing resources can be migrated with a moved block.",
 	}
 }
 
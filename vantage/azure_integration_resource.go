@@ -0,0 +1,274 @@
+package vantage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vantage-sh/terraform-provider-vantage/vantage/planmodifiers"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	integrationsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/integrations"
+)
+
+var (
+	_ resource.Resource                = (*AzureIntegrationResource)(nil)
+	_ resource.ResourceWithConfigure   = (*AzureIntegrationResource)(nil)
+	_ resource.ResourceWithImportState = (*AzureIntegrationResource)(nil)
+)
+
+type AzureIntegrationResource struct{ client *Client }
+
+func NewAzureIntegrationResource() resource.Resource { return &AzureIntegrationResource{} }
+
+type AzureIntegrationResourceModel struct {
+	TenantId        types.String `tfsdk:"tenant_id"`
+	AppId           types.String `tfsdk:"app_id"`
+	ClientSecret    types.String `tfsdk:"client_secret"`
+	SubscriptionIds types.Set    `tfsdk:"subscription_ids"`
+	Workspaces      types.Set    `tfsdk:"workspaces"`
+	Token           types.String `tfsdk:"token"`
+	Id              types.String `tfsdk:"id"`
+	Status          types.String `tfsdk:"status"`
+}
+
+func (r *AzureIntegrationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *AzureIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_azure_integration"
+}
+
+func (r *AzureIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tenant_id": schema.StringAttribute{
+				Required: true,
+				// Remove when API supports updating.
+				PlanModifiers:       []planmodifier.String{planmodifiers.ImmutableAfterCreate("tenant_id")},
+				MarkdownDescription: "The Azure Active Directory tenant id of the service principal. Cannot be changed after creation.",
+			},
+			"app_id": schema.StringAttribute{
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{planmodifiers.ImmutableAfterCreate("app_id")},
+				MarkdownDescription: "The application (client) id of the service principal. Cannot be changed after creation.",
+			},
+			"client_secret": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				// The secret cannot be updated in place and ImmutableAfterCreate
+				// would echo it in its warning, so a change replaces the
+				// integration instead. A secret first configured after import
+				// is accepted without replacement.
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Changing the client secret replaces the integration.",
+						"Changing the client secret replaces the integration.",
+					),
+				},
+				MarkdownDescription: "The client secret of the service principal. Not returned by the API. Changing it replaces the integration.",
+			},
+			"subscription_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.SetRequest, resp *setplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Changing the subscription scope replaces the integration.",
+						"Changing the subscription scope replaces the integration.",
+					),
+				},
+				MarkdownDescription: "Limit the integration to these Azure subscription ids. If omitted, every subscription the service principal can read is imported. Changing it replaces the integration.",
+			},
+			"workspaces": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers:       []planmodifier.Set{planmodifiers.UseStateWhenEmpty()},
+				MarkdownDescription: "Workspace tokens to associate with the integration. Can be updated in-place. Note: the Vantage API requires at least one token — workspace associations cannot be fully removed once set.",
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "Unique token of the Azure integration.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "Same as token.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "The status of the integration. On create, the provider waits for the integration to connect or error before returning. This is system-managed and cannot be configured.",
+			},
+		},
+		MarkdownDescription: "Manages an Azure integration that imports costs using a service principal.",
+	}
+}
+
+func (r *AzureIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("token"), req, resp)
+}
+
+func (r *AzureIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AzureIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := &modelsv2.CreateAzureIntegration{
+		Tenant:   data.TenantId.ValueStringPointer(),
+		AppID:    data.AppId.ValueStringPointer(),
+		Password: data.ClientSecret.ValueStringPointer(),
+	}
+	if !data.SubscriptionIds.IsNull() && !data.SubscriptionIds.IsUnknown() {
+		resp.Diagnostics.Append(data.SubscriptionIds.ElementsAs(ctx, &payload.SubscriptionIds, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	params := integrationsv2.NewCreateAzureIntegrationParams()
+	params.WithCreateAzureIntegration(payload)
+
+	out, err := r.client.V2.Integrations.CreateAzureIntegration(params, r.client.Auth)
+	if err != nil {
+		if e, ok := err.(*integrationsv2.CreateAzureIntegrationBadRequest); ok {
+			handleBadRequest("Create Azure Integration", &resp.Diagnostics, e.GetPayload())
+			return
+		}
+		handleError("Create Azure Integration", &resp.Diagnostics, err)
+		return
+	}
+
+	data.Token = types.StringValue(out.Payload.Token)
+	data.Id = types.StringValue(out.Payload.Token)
+	data.Status = types.StringValue(out.Payload.Status)
+
+	// Associate workspaces immediately after creation if specified;
+	// otherwise resolve to an empty set so the value is always known after apply.
+	if !data.Workspaces.IsNull() && !data.Workspaces.IsUnknown() {
+		data.Workspaces = applyIntegrationWorkspaces(ctx, r.client, "Update Azure Integration Workspaces", out.Payload.Token, data.Workspaces, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			// The integration exists: persist it so that it is tainted
+			// rather than orphaned.
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	} else {
+		data.Workspaces, _ = types.SetValueFrom(ctx, types.StringType, []string{})
+	}
+
+	// Persist state even if the integration fails to connect so that it is
+	// tainted, rather than orphaned, and replaced on the next apply.
+	if integration := awaitIntegrationConnected(ctx, r.client, "Create Azure Integration", out.Payload.Token, &resp.Diagnostics); integration != nil {
+		data.Status = types.StringValue(integration.Status)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AzureIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AzureIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := integrationsv2.NewGetIntegrationParams()
+	params.SetIntegrationToken(state.Token.ValueString())
+
+	out, err := r.client.V2.Integrations.GetIntegration(params, r.client.Auth)
+	if err != nil {
+		if _, ok := err.(*integrationsv2.GetIntegrationNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		handleError("Read Azure Integration", &resp.Diagnostics, err)
+		return
+	}
+
+	state.Token = types.StringValue(out.Payload.Token)
+	state.Id = types.StringValue(out.Payload.Token)
+	state.Status = types.StringValue(out.Payload.Status)
+
+	workspaces, diags := types.SetValueFrom(ctx, types.StringType, out.Payload.WorkspaceTokens)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Workspaces = workspaces
+
+	// app_id, client_secret and subscription_ids are not returned by the
+	// generic GET endpoint, so preserve whatever is already in state. On a
+	// fresh import the tenant id is seeded from AccountIdentifier.
+	if state.TenantId.IsNull() || state.TenantId.ValueString() == "" {
+		if out.Payload.AccountIdentifier != nil {
+			state.TenantId = types.StringValue(*out.Payload.AccountIdentifier)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *AzureIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// tenant_id and app_id are guarded by ImmutableAfterCreate plan modifiers
+	// and the other credentials force replacement, so only workspace changes
+	// (and credentials first configured after import) reach Update.
+	var plan AzureIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state AzureIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Token = state.Token
+	plan.Id = state.Id
+	plan.Status = state.Status
+
+	plan.Workspaces = applyIntegrationWorkspaces(ctx, r.client, "Update Azure Integration Workspaces", state.Token.ValueString(), plan.Workspaces, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AzureIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AzureIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := integrationsv2.NewDeleteIntegrationParams()
+	params.SetIntegrationToken(state.Token.ValueString())
+
+	_, err := r.client.V2.Integrations.DeleteIntegration(params, r.client.Auth)
+	if err != nil {
+		if _, ok := err.(*integrationsv2.DeleteIntegrationNotFound); ok {
+			return
+		}
+		handleError("Delete Azure Integration", &resp.Diagnostics, err)
+	}
+}
@@ -0,0 +1,179 @@
+package vantage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	budgetsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/budgets"
+)
+
+// budgetFetchFunc returns the Budget with the given token.
+type budgetFetchFunc func(token string) (*modelsv2.Budget, error)
+
+// budgetFetcher fetches Budgets from the API, memoizing each token so a
+// hierarchy walk fetches every Budget at most once.
+func budgetFetcher(client *Client, includePerformance bool) budgetFetchFunc {
+	cache := map[string]*modelsv2.Budget{}
+	return func(token string) (*modelsv2.Budget, error) {
+		if b, ok := cache[token]; ok {
+			return b, nil
+		}
+		params := budgetsv2.NewGetBudgetParams().WithBudgetToken(token).WithIncludePerformance(&includePerformance)
+		out, err := client.V2.Budgets.GetBudget(params, client.Auth)
+		if err != nil {
+			return nil, err
+		}
+		cache[token] = out.Payload
+		return out.Payload, nil
+	}
+}
+
+// budgetHierarchyNode is a Budget visited by walkBudgetHierarchy.
+type budgetHierarchyNode struct {
+	Budget            *modelsv2.Budget
+	ParentBudgetToken *string
+	Depth             int
+}
+
+// budgetCycleError reports a hierarchy in which a Budget is its own
+// descendant. Path lists the tokens from the first Budget of the cycle back
+// to itself.
+type budgetCycleError struct {
+	Path []string
+}
+
+func (e *budgetCycleError) Error() string {
+	return fmt.Sprintf("budget hierarchy contains a cycle: %s", strings.Join(e.Path, " -> "))
+}
+
+// walkBudgetHierarchy visits root and its descendants depth-first, in the
+// order of child_budget_tokens. root may be a Budget that does not exist yet,
+// e.g. one being planned; its token is only used for cycle detection. A
+// *budgetCycleError is returned if any Budget is reachable from itself.
+func walkBudgetHierarchy(root *modelsv2.Budget, fetch budgetFetchFunc) ([]budgetHierarchyNode, error) {
+	var nodes []budgetHierarchyNode
+	var stack []string
+	onStack := map[string]bool{}
+	visited := map[string]bool{}
+
+	var visit func(b *modelsv2.Budget, parent *string, depth int) error
+	visit = func(b *modelsv2.Budget, parent *string, depth int) error {
+		nodes = append(nodes, budgetHierarchyNode{Budget: b, ParentBudgetToken: parent, Depth: depth})
+		if b.Token != "" {
+			stack = append(stack, b.Token)
+			onStack[b.Token] = true
+			visited[b.Token] = true
+			defer func() {
+				stack = stack[:len(stack)-1]
+				delete(onStack, b.Token)
+			}()
+		}
+
+		for _, childToken := range b.ChildBudgetTokens {
+			if onStack[childToken] {
+				start := 0
+				for i, t := range stack {
+					if t == childToken {
+						start = i
+						break
+					}
+				}
+				path := append(append([]string{}, stack[start:]...), childToken)
+				return &budgetCycleError{Path: path}
+			}
+			if visited[childToken] {
+				continue
+			}
+			child, err := fetch(childToken)
+			if err != nil {
+				return err
+			}
+			token := b.Token
+			if err := visit(child, &token, depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := visit(root, nil, 0); err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
+
+// rollUpBudgetPeriods sums the period amounts of the leaf Budgets in nodes,
+// the Budgets without children, by period start date. nodes[0] is the root
+// and is never counted itself. The result is sorted by start date.
+func rollUpBudgetPeriods(nodes []budgetHierarchyNode) []budgetSchedulePeriod {
+	totals := map[string]*budgetSchedulePeriod{}
+	for i, n := range nodes {
+		if i == 0 || len(n.Budget.ChildBudgetTokens) > 0 {
+			continue
+		}
+		for _, p := range n.Budget.Periods {
+			amount, err := strconv.ParseFloat(p.Amount, 64)
+			if err != nil {
+				continue
+			}
+			if t, ok := totals[p.StartAt]; ok {
+				t.Amount += amount
+				continue
+			}
+			totals[p.StartAt] = &budgetSchedulePeriod{StartAt: p.StartAt, EndAt: p.EndAt, Amount: amount}
+		}
+	}
+
+	periods := make([]budgetSchedulePeriod, 0, len(totals))
+	for _, t := range totals {
+		periods = append(periods, *t)
+	}
+	sort.Slice(periods, func(i, j int) bool { return periods[i].StartAt < periods[j].StartAt })
+	return periods
+}
+
+func rolledUpPeriodsAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "For a hierarchical Budget, the sum of the period amounts of the leaf Budgets under it, by period start date. Null when `child_budget_tokens` is empty.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"start_at": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The start date of the period.",
+				},
+				"end_at": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The end date of the period.",
+				},
+				"amount": schema.Float64Attribute{
+					Computed:            true,
+					MarkdownDescription: "The total amount of the period across the leaf Budgets.",
+				},
+			},
+		},
+	}
+}
+
+// rolledUpPeriodsValue converts rolled up periods into the value of
+// rolled_up_periods.
+func rolledUpPeriodsValue(ctx context.Context, periods []budgetSchedulePeriod, diags *diag.Diagnostics) types.List {
+	models := make([]budgetPeriodResourceModel, 0, len(periods))
+	for _, p := range periods {
+		models = append(models, budgetPeriodResourceModel{
+			Amount:  types.Float64Value(p.Amount),
+			EndAt:   types.StringValue(p.EndAt),
+			StartAt: types.StringValue(p.StartAt),
+		})
+	}
+	l, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: budgetPeriodAttrTypes}, models)
+	diags.Append(d...)
+	return l
+}
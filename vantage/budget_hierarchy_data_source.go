@@ -0,0 +1,220 @@
+package vantage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	budgetsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/budgets"
+)
+
+var (
+	_ datasource.DataSource              = (*budgetHierarchyDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*budgetHierarchyDataSource)(nil)
+)
+
+func NewBudgetHierarchyDataSource() datasource.DataSource {
+	return &budgetHierarchyDataSource{}
+}
+
+type budgetHierarchyDataSource struct {
+	client *Client
+}
+
+type budgetHierarchyDataSourceModel struct {
+	BudgetToken types.String                 `tfsdk:"budget_token"`
+	Nodes       []budgetHierarchyNodeModel   `tfsdk:"nodes"`
+	Periods     []budgetHierarchyPeriodModel `tfsdk:"rolled_up_periods"`
+}
+
+type budgetHierarchyNodeModel struct {
+	Token             types.String                      `tfsdk:"token"`
+	Name              types.String                      `tfsdk:"name"`
+	ParentBudgetToken types.String                      `tfsdk:"parent_budget_token"`
+	Depth             types.Int64                       `tfsdk:"depth"`
+	ChildBudgetTokens types.List                        `tfsdk:"child_budget_tokens"`
+	CostReportToken   types.String                      `tfsdk:"cost_report_token"`
+	Performance       []budgetHierarchyPerformanceModel `tfsdk:"performance"`
+}
+
+type budgetHierarchyPerformanceModel struct {
+	Date   types.String `tfsdk:"date"`
+	Actual types.String `tfsdk:"actual"`
+	Amount types.String `tfsdk:"amount"`
+}
+
+type budgetHierarchyPeriodModel struct {
+	StartAt types.String  `tfsdk:"start_at"`
+	EndAt   types.String  `tfsdk:"end_at"`
+	Amount  types.Float64 `tfsdk:"amount"`
+}
+
+func (d *budgetHierarchyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*Client)
+}
+
+func (d *budgetHierarchyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_budget_hierarchy"
+}
+
+func (d *budgetHierarchyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns a hierarchical Budget and all of the Budgets under it, with the actual and budgeted amounts of each.",
+		Attributes: map[string]schema.Attribute{
+			"budget_token": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The token of the Budget at the top of the hierarchy.",
+			},
+			"nodes": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Budget and its descendants, depth-first in the order of `child_budget_tokens`. A Budget reachable through more than one parent is listed once.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"token": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The token of the Budget.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the Budget.",
+						},
+						"parent_budget_token": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The token of the Budget this one was reached from. Null for the top of the hierarchy.",
+						},
+						"depth": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of levels below the top of the hierarchy. The top has a depth of 0.",
+						},
+						"child_budget_tokens": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The tokens of the Budget's direct children.",
+						},
+						"cost_report_token": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The token of the Budget's Cost Report. Null for hierarchical Budgets.",
+						},
+						"performance": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The actual and budgeted amounts of the Budget by period.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"date": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The date of the period.",
+									},
+									"actual": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The actual spend of the period.",
+									},
+									"amount": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The budgeted amount of the period.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"rolled_up_periods": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The sum of the period amounts of the leaf Budgets in the hierarchy, by period start date.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The start date of the period.",
+						},
+						"end_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The end date of the period.",
+						},
+						"amount": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The total amount of the period across the leaf Budgets.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *budgetHierarchyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state budgetHierarchyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fetch := budgetFetcher(d.client, true)
+	root, err := fetch(state.BudgetToken.ValueString())
+	if err != nil {
+		if _, ok := err.(*budgetsv2.GetBudgetNotFound); ok {
+			resp.Diagnostics.AddAttributeError(path.Root("budget_token"), "Budget Not Found",
+				fmt.Sprintf("No budget with token %q was found.", state.BudgetToken.ValueString()))
+			return
+		}
+		handleError("Get Budget", &resp.Diagnostics, err)
+		return
+	}
+
+	nodes, err := walkBudgetHierarchy(root, fetch)
+	if err != nil {
+		var cycle *budgetCycleError
+		if errors.As(err, &cycle) {
+			resp.Diagnostics.AddError("Budget Hierarchy Cycle", fmt.Sprintf("The budget hierarchy is cyclic: %s.", strings.Join(cycle.Path, " -> ")))
+			return
+		}
+		handleError("Read Budget Hierarchy", &resp.Diagnostics, err)
+		return
+	}
+
+	state.Nodes = make([]budgetHierarchyNodeModel, 0, len(nodes))
+	for _, n := range nodes {
+		children, diag := types.ListValueFrom(ctx, types.StringType, n.Budget.ChildBudgetTokens)
+		if diag.HasError() {
+			resp.Diagnostics.Append(diag...)
+			return
+		}
+		performance := make([]budgetHierarchyPerformanceModel, 0, len(n.Budget.Performance))
+		for _, p := range n.Budget.Performance {
+			performance = append(performance, budgetHierarchyPerformanceModel{
+				Date:   types.StringValue(p.Date),
+				Actual: types.StringValue(p.Actual),
+				Amount: types.StringValue(p.Amount),
+			})
+		}
+		state.Nodes = append(state.Nodes, budgetHierarchyNodeModel{
+			Token:             types.StringValue(n.Budget.Token),
+			Name:              types.StringPointerValue(n.Budget.Name),
+			ParentBudgetToken: types.StringPointerValue(n.ParentBudgetToken),
+			Depth:             types.Int64Value(int64(n.Depth)),
+			ChildBudgetTokens: children,
+			CostReportToken:   types.StringPointerValue(n.Budget.CostReportToken),
+			Performance:       performance,
+		})
+	}
+
+	rolledUp := rollUpBudgetPeriods(nodes)
+	state.Periods = make([]budgetHierarchyPeriodModel, 0, len(rolledUp))
+	for _, p := range rolledUp {
+		state.Periods = append(state.Periods, budgetHierarchyPeriodModel{
+			StartAt: types.StringValue(p.StartAt),
+			EndAt:   types.StringValue(p.EndAt),
+			Amount:  types.Float64Value(p.Amount),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,115 @@
+package vantage
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+)
+
+func testBudgetFetch(budgets ...*modelsv2.Budget) budgetFetchFunc {
+	byToken := map[string]*modelsv2.Budget{}
+	for _, b := range budgets {
+		byToken[b.Token] = b
+	}
+	return func(token string) (*modelsv2.Budget, error) {
+		if b, ok := byToken[token]; ok {
+			return b, nil
+		}
+		return nil, fmt.Errorf("budget %s not found", token)
+	}
+}
+
+func testLeafBudget(token string, amounts map[string]string) *modelsv2.Budget {
+	b := &modelsv2.Budget{Token: token}
+	for start, amount := range amounts {
+		b.Periods = append(b.Periods, &modelsv2.BudgetPeriod{StartAt: start, EndAt: start[:8] + "28", Amount: amount})
+	}
+	return b
+}
+
+func TestWalkBudgetHierarchy(t *testing.T) {
+	root := &modelsv2.Budget{Token: "bdgt_root", ChildBudgetTokens: []string{"bdgt_eng", "bdgt_data"}}
+	eng := &modelsv2.Budget{Token: "bdgt_eng", ChildBudgetTokens: []string{"bdgt_web", "bdgt_data"}}
+	fetch := testBudgetFetch(
+		eng,
+		testLeafBudget("bdgt_web", nil),
+		testLeafBudget("bdgt_data", nil),
+	)
+
+	nodes, err := walkBudgetHierarchy(root, fetch)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []string
+	for _, n := range nodes {
+		parent := ""
+		if n.ParentBudgetToken != nil {
+			parent = *n.ParentBudgetToken
+		}
+		got = append(got, fmt.Sprintf("%s<%s@%d", n.Budget.Token, parent, n.Depth))
+	}
+	want := "bdgt_root<@0 bdgt_eng<bdgt_root@1 bdgt_web<bdgt_eng@2 bdgt_data<bdgt_eng@2"
+	if strings.Join(got, " ") != want {
+		t.Errorf("walk = %v, want %s", got, want)
+	}
+}
+
+func TestWalkBudgetHierarchy_cycle(t *testing.T) {
+	fetch := testBudgetFetch(
+		&modelsv2.Budget{Token: "bdgt_a", ChildBudgetTokens: []string{"bdgt_b"}},
+		&modelsv2.Budget{Token: "bdgt_b", ChildBudgetTokens: []string{"bdgt_self"}},
+	)
+	root := &modelsv2.Budget{Token: "bdgt_self", ChildBudgetTokens: []string{"bdgt_a"}}
+
+	_, err := walkBudgetHierarchy(root, fetch)
+	var cycle *budgetCycleError
+	if !errors.As(err, &cycle) {
+		t.Fatalf("expected a cycle error, got %v", err)
+	}
+	if got := strings.Join(cycle.Path, " -> "); got != "bdgt_self -> bdgt_a -> bdgt_b -> bdgt_self" {
+		t.Errorf("unexpected cycle path %s", got)
+	}
+
+	// A planned Budget without a token can't be part of a cycle.
+	root.Token = ""
+	fetch = testBudgetFetch(
+		&modelsv2.Budget{Token: "bdgt_a", ChildBudgetTokens: []string{"bdgt_b"}},
+		&modelsv2.Budget{Token: "bdgt_b"},
+	)
+	if _, err := walkBudgetHierarchy(root, fetch); err != nil {
+		t.Errorf("unexpected error for a new budget: %v", err)
+	}
+}
+
+func TestRollUpBudgetPeriods(t *testing.T) {
+	root := &modelsv2.Budget{Token: "bdgt_root", ChildBudgetTokens: []string{"bdgt_eng", "bdgt_ops"}}
+	fetch := testBudgetFetch(
+		&modelsv2.Budget{Token: "bdgt_eng", ChildBudgetTokens: []string{"bdgt_web"}, Periods: []*modelsv2.BudgetPeriod{
+			{StartAt: "2025-01-01", EndAt: "2025-01-31", Amount: "9999"},
+		}},
+		testLeafBudget("bdgt_web", map[string]string{"2025-01-01": "100.50", "2025-02-01": "200"}),
+		testLeafBudget("bdgt_ops", map[string]string{"2025-01-01": "50"}),
+	)
+
+	nodes, err := walkBudgetHierarchy(root, fetch)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := rollUpBudgetPeriods(nodes)
+	want := []budgetSchedulePeriod{
+		{StartAt: "2025-01-01", EndAt: "2025-01-28", Amount: 150.5},
+		{StartAt: "2025-02-01", EndAt: "2025-02-28", Amount: 200},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d periods, got %+v", len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("period %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
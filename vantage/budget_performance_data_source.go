@@ -0,0 +1,262 @@
+package vantage
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	budgetsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/budgets"
+)
+
+var (
+	_ datasource.DataSource              = (*budgetPerformanceDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*budgetPerformanceDataSource)(nil)
+)
+
+var budgetPerformanceDateRegexp = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+func NewBudgetPerformanceDataSource() datasource.DataSource {
+	return &budgetPerformanceDataSource{}
+}
+
+type budgetPerformanceDataSource struct {
+	client *Client
+}
+
+type budgetPerformanceDataSourceModel struct {
+	BudgetToken          types.String                   `tfsdk:"budget_token"`
+	AsOf                 types.String                   `tfsdk:"as_of"`
+	Name                 types.String                   `tfsdk:"name"`
+	CurrentPeriodStartAt types.String                   `tfsdk:"current_period_start_at"`
+	OverBudget           types.Bool                     `tfsdk:"over_budget"`
+	Periods              []budgetPerformancePeriodModel `tfsdk:"periods"`
+}
+
+type budgetPerformancePeriodModel struct {
+	StartAt         types.String  `tfsdk:"start_at"`
+	EndAt           types.String  `tfsdk:"end_at"`
+	Actual          types.Float64 `tfsdk:"actual"`
+	Amount          types.Float64 `tfsdk:"amount"`
+	PercentConsumed types.Float64 `tfsdk:"percent_consumed"`
+	ForecastedSpend types.Float64 `tfsdk:"forecasted_spend"`
+	OverBudget      types.Bool    `tfsdk:"over_budget"`
+	Current         types.Bool    `tfsdk:"current"`
+}
+
+func (d *budgetPerformanceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*Client)
+}
+
+func (d *budgetPerformanceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_budget_performance"
+}
+
+func (d *budgetPerformanceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns the actual and budgeted spend of a Budget for each period, with a forecast of the current period's spend. Use `over_budget` in a `precondition` to stop an apply while a Budget is forecast to be exceeded.",
+		Attributes: map[string]schema.Attribute{
+			"budget_token": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The token of the Budget.",
+			},
+			"as_of": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The date, formatted `YYYY-MM-DD`, used to pick the current period and forecast its spend. Defaults to today in UTC.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(budgetPerformanceDateRegexp, "must be formatted YYYY-MM-DD"),
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the Budget.",
+			},
+			"current_period_start_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The start date of the period containing `as_of`. Null when no period contains it.",
+			},
+			"over_budget": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the current period is forecast to exceed its amount. False when no period contains `as_of`.",
+			},
+			"periods": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The performance of the Budget by period, ordered by start date.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The start date of the period.",
+						},
+						"end_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The end date of the period. Null when the Budget does not define one.",
+						},
+						"actual": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The spend so far in the period.",
+						},
+						"amount": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The budgeted amount of the period.",
+						},
+						"percent_consumed": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "`actual` as a percentage of `amount`. Null when `amount` is 0.",
+						},
+						"forecasted_spend": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The spend expected by the end of the period, extrapolated linearly from `actual` for the current period. Equal to `actual` for other periods.",
+						},
+						"over_budget": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether `forecasted_spend` exceeds `amount`.",
+						},
+						"current": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the period contains `as_of`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *budgetPerformanceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state budgetPerformanceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	asOf := time.Now().UTC()
+	if v := state.AsOf.ValueString(); v != "" {
+		t, err := time.Parse(time.DateOnly, v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("as_of"), "Invalid Date", fmt.Sprintf("%q must be formatted YYYY-MM-DD.", v))
+			return
+		}
+		asOf = t
+	}
+
+	includePerformance := true
+	params := budgetsv2.NewGetBudgetParams().WithBudgetToken(state.BudgetToken.ValueString()).WithIncludePerformance(&includePerformance)
+	out, err := d.client.V2.Budgets.GetBudget(params, d.client.Auth)
+	if err != nil {
+		if _, ok := err.(*budgetsv2.GetBudgetNotFound); ok {
+			resp.Diagnostics.AddAttributeError(path.Root("budget_token"), "Budget Not Found",
+				fmt.Sprintf("No budget with token %q was found.", state.BudgetToken.ValueString()))
+			return
+		}
+		handleError("Get Budget", &resp.Diagnostics, err)
+		return
+	}
+
+	state.Name = types.StringPointerValue(out.Payload.Name)
+	state.CurrentPeriodStartAt = types.StringNull()
+	state.OverBudget = types.BoolValue(false)
+	state.Periods = []budgetPerformancePeriodModel{}
+	for _, p := range budgetPerformancePeriods(out.Payload, asOf) {
+		m := budgetPerformancePeriodModel{
+			StartAt:         types.StringValue(p.StartAt),
+			EndAt:           types.StringNull(),
+			Actual:          types.Float64Value(p.Actual),
+			Amount:          types.Float64Value(p.Amount),
+			PercentConsumed: types.Float64Null(),
+			ForecastedSpend: types.Float64Value(p.ForecastedSpend),
+			OverBudget:      types.BoolValue(p.OverBudget),
+			Current:         types.BoolValue(p.Current),
+		}
+		if p.EndAt != "" {
+			m.EndAt = types.StringValue(p.EndAt)
+		}
+		if p.Amount != 0 {
+			m.PercentConsumed = types.Float64Value(p.PercentConsumed)
+		}
+		if p.Current {
+			state.CurrentPeriodStartAt = m.StartAt
+			state.OverBudget = m.OverBudget
+		}
+		state.Periods = append(state.Periods, m)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// budgetPerformancePeriod is the performance of one budget period.
+type budgetPerformancePeriod struct {
+	StartAt         string
+	EndAt           string
+	Actual          float64
+	Amount          float64
+	PercentConsumed float64
+	ForecastedSpend float64
+	OverBudget      bool
+	Current         bool
+}
+
+// budgetPerformancePeriods pairs each performance entry of b with the period
+// starting on the same date and forecasts the spend of the period containing
+// asOf. Performance dates may be dates or timestamps; only the date is used.
+func budgetPerformancePeriods(b *modelsv2.Budget, asOf time.Time) []budgetPerformancePeriod {
+	endAt := map[string]string{}
+	for _, p := range b.Periods {
+		endAt[budgetDatePart(p.StartAt)] = budgetDatePart(p.EndAt)
+	}
+
+	periods := make([]budgetPerformancePeriod, 0, len(b.Performance))
+	for _, perf := range b.Performance {
+		actual, _ := strconv.ParseFloat(perf.Actual, 64)
+		amount, _ := strconv.ParseFloat(perf.Amount, 64)
+		p := budgetPerformancePeriod{
+			StartAt:         budgetDatePart(perf.Date),
+			Actual:          actual,
+			Amount:          amount,
+			ForecastedSpend: actual,
+		}
+		p.EndAt = endAt[p.StartAt]
+		if amount != 0 {
+			p.PercentConsumed = math.Round(actual/amount*10000) / 100
+		}
+
+		start, startErr := time.Parse(time.DateOnly, p.StartAt)
+		end, endErr := time.Parse(time.DateOnly, p.EndAt)
+		if startErr == nil && endErr == nil && !asOf.Before(start) && asOf.Before(end) {
+			p.Current = true
+			// Count the as_of day as spent so a period's first day doesn't
+			// divide by zero.
+			elapsed := asOf.Sub(start).Hours()/24 + 1
+			total := end.Sub(start).Hours() / 24
+			if elapsed < total {
+				p.ForecastedSpend = math.Round(actual*total/elapsed*100) / 100
+			}
+		}
+		p.OverBudget = p.ForecastedSpend > amount
+		periods = append(periods, p)
+	}
+
+	sort.Slice(periods, func(i, j int) bool { return periods[i].StartAt < periods[j].StartAt })
+	return periods
+}
+
+// budgetDatePart returns the YYYY-MM-DD prefix of a date or timestamp.
+func budgetDatePart(v string) string {
+	if len(v) > len(time.DateOnly) {
+		return v[:len(time.DateOnly)]
+	}
+	return v
+}
@@ -0,0 +1,56 @@
+package vantage
+
+import (
+	"testing"
+	"time"
+
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+)
+
+func TestBudgetPerformancePeriods(t *testing.T) {
+	b := &modelsv2.Budget{
+		Periods: []*modelsv2.BudgetPeriod{
+			{StartAt: "2025-01-01", EndAt: "2025-01-31", Amount: "1000"},
+			{StartAt: "2025-02-01", EndAt: "2025-03-03", Amount: "1000"},
+		},
+		Performance: []*modelsv2.BudgetPerformance{
+			{Date: "2025-02-01T00:00:00Z", Actual: "400", Amount: "1000"},
+			{Date: "2025-01-01T00:00:00Z", Actual: "1200", Amount: "1000"},
+			{Date: "2025-03-01T00:00:00Z", Actual: "0", Amount: "0"},
+		},
+	}
+
+	got := budgetPerformancePeriods(b, time.Date(2025, time.February, 10, 0, 0, 0, 0, time.UTC))
+	if len(got) != 3 {
+		t.Fatalf("expected 3 periods, got %+v", got)
+	}
+
+	jan, feb, mar := got[0], got[1], got[2]
+	if jan.StartAt != "2025-01-01" || jan.EndAt != "2025-01-31" {
+		t.Errorf("unexpected first period %+v", jan)
+	}
+	if jan.Current || !jan.OverBudget || jan.ForecastedSpend != 1200 || jan.PercentConsumed != 120 {
+		t.Errorf("expected a past period over budget with forecast equal to actual, got %+v", jan)
+	}
+
+	// 10 of 30 days elapsed: 400 / 10 * 30.
+	if !feb.Current || feb.ForecastedSpend != 1200 || !feb.OverBudget || feb.PercentConsumed != 40 {
+		t.Errorf("expected the current period forecast over budget, got %+v", feb)
+	}
+
+	if mar.EndAt != "" || mar.Current || mar.OverBudget {
+		t.Errorf("expected a period without an end date to be left alone, got %+v", mar)
+	}
+}
+
+func TestBudgetPerformancePeriods_firstDay(t *testing.T) {
+	b := &modelsv2.Budget{
+		Periods:     []*modelsv2.BudgetPeriod{{StartAt: "2025-04-01", EndAt: "2025-05-01", Amount: "3000"}},
+		Performance: []*modelsv2.BudgetPerformance{{Date: "2025-04-01", Actual: "50", Amount: "3000"}},
+	}
+
+	got := budgetPerformancePeriods(b, time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC))
+	if len(got) != 1 || got[0].ForecastedSpend != 1500 || got[0].OverBudget {
+		t.Errorf("expected a first-day forecast of 1500 under budget, got %+v", got)
+	}
+}
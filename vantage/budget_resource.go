@@ -2,21 +2,30 @@ package vantage
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/vantage-sh/terraform-provider-vantage/vantage/resource_budget"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
 	budgetsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/budgets"
 )
 
 var (
-	_ resource.Resource                = (*budgetResource)(nil)
-	_ resource.ResourceWithConfigure   = (*budgetResource)(nil)
-	_ resource.ResourceWithImportState = (*budgetResource)(nil)
+	_ resource.Resource                     = (*budgetResource)(nil)
+	_ resource.ResourceWithConfigure        = (*budgetResource)(nil)
+	_ resource.ResourceWithImportState      = (*budgetResource)(nil)
+	_ resource.ResourceWithModifyPlan       = (*budgetResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*budgetResource)(nil)
 )
 
 func NewBudgetResource() resource.Resource {
@@ -27,6 +36,19 @@ type budgetResource struct {
 	client *Client
 }
 
+// budgetResourceModel adds the resource-only schedule and rolled_up_periods
+// attributes to the generated budget model shared with the data source.
+type budgetResourceModel struct {
+	resource_budget.BudgetModel
+	Schedule        types.Object `tfsdk:"schedule"`
+	RolledUpPeriods types.List   `tfsdk:"rolled_up_periods"`
+}
+
+// budget returns the shared model for the conversion helpers.
+func (m *budgetResourceModel) budget() *budgetModel {
+	return (*budgetModel)(&m.BudgetModel)
+}
+
 func (r *budgetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -48,11 +70,160 @@ func (r *budgetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			stringplanmodifier.UseStateForUnknown(),
 		},
 	}
+	s.Attributes["schedule"] = budgetScheduleAttribute()
+	s.Attributes["rolled_up_periods"] = rolledUpPeriodsAttribute()
 	resp.Schema = s
 }
 
+func (r *budgetResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("schedule"),
+			path.MatchRoot("periods"),
+		),
+		// A hierarchical Budget takes its periods from its children and has
+		// no Cost Report of its own.
+		resourcevalidator.Conflicting(
+			path.MatchRoot("child_budget_tokens"),
+			path.MatchRoot("cost_report_token"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("child_budget_tokens"),
+			path.MatchRoot("periods"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("child_budget_tokens"),
+			path.MatchRoot("schedule"),
+		),
+	}
+}
+
+// ModifyPlan expands a configured schedule into periods so the generated
+// periods appear in the plan, and checks changed child budgets for cycles.
+func (r *budgetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.modifyPlanSchedule(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.modifyPlanHierarchy(ctx, req, resp)
+}
+
+func (r *budgetResource) modifyPlanSchedule(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var schedule types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("schedule"), &schedule)...)
+	if resp.Diagnostics.HasError() || schedule.IsNull() {
+		return
+	}
+
+	var statePeriods types.List
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("periods"), &statePeriods)...)
+	}
+
+	periods := budgetSchedulePeriods(ctx, schedule, statePeriods, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("periods"), periods)...)
+}
+
+// modifyPlanHierarchy keeps rolled_up_periods from state while the children
+// are unchanged and the Budget has no update. When the children change, it
+// walks the planned children to reject a hierarchy that would contain this
+// Budget, or any other cycle. Children that are created in the same apply
+// have unknown tokens and are checked by the API instead; Terraform already
+// rejects two new Budgets listing each other.
+func (r *budgetResource) modifyPlanHierarchy(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	rolledUpPath := path.Root("rolled_up_periods")
+	childrenPath := path.Root("child_budget_tokens")
+	elemType := types.ObjectType{AttrTypes: budgetPeriodAttrTypes}
+
+	var planned types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, childrenPath, &planned)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if planned.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, rolledUpPath, types.ListUnknown(elemType))...)
+		return
+	}
+	if len(planned.Elements()) == 0 {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, rolledUpPath, types.ListNull(elemType))...)
+		return
+	}
+
+	var token types.String
+	if !req.State.Raw.IsNull() {
+		var current, rolledUp types.List
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, childrenPath, &current)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, rolledUpPath, &rolledUp)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("token"), &token)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if planned.Equal(current) {
+			// Update recomputes the roll-up from the children, whose periods
+			// may change in the same apply, so state is only kept when this
+			// Budget isn't updated at all.
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, rolledUpPath, rolledUp)...)
+			if !resp.Plan.Raw.Equal(req.State.Raw) {
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, rolledUpPath, types.ListUnknown(elemType))...)
+			}
+			return
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, rolledUpPath, types.ListUnknown(elemType))...)
+
+	if r.client == nil {
+		return
+	}
+	root := &modelsv2.Budget{Token: token.ValueString()}
+	for _, v := range planned.Elements() {
+		child, ok := v.(types.String)
+		if !ok || child.IsUnknown() || child.IsNull() {
+			continue
+		}
+		root.ChildBudgetTokens = append(root.ChildBudgetTokens, child.ValueString())
+	}
+
+	_, err := walkBudgetHierarchy(root, budgetFetcher(r.client, false))
+	var cycle *budgetCycleError
+	var notFound *budgetsv2.GetBudgetNotFound
+	switch {
+	case err == nil:
+	case errors.As(err, &cycle):
+		resp.Diagnostics.AddAttributeError(childrenPath, "Budget Hierarchy Cycle",
+			fmt.Sprintf("The planned child budgets would make the hierarchy cyclic: %s.", strings.Join(cycle.Path, " -> ")))
+	case errors.As(err, &notFound):
+		resp.Diagnostics.AddAttributeError(childrenPath, "Child Budget Not Found",
+			"One of the planned child budgets does not exist.")
+	default:
+		handleError("Get Budget", &resp.Diagnostics, err)
+	}
+}
+
+// setRolledUpPeriods computes rolled_up_periods for src by walking its
+// children. It is null when src has no children.
+func (r *budgetResource) setRolledUpPeriods(ctx context.Context, src *modelsv2.Budget, data *budgetResourceModel, diags *diag.Diagnostics) {
+	if len(src.ChildBudgetTokens) == 0 {
+		data.RolledUpPeriods = types.ListNull(types.ObjectType{AttrTypes: budgetPeriodAttrTypes})
+		return
+	}
+
+	nodes, err := walkBudgetHierarchy(src, budgetFetcher(r.client, false))
+	if err != nil {
+		handleError("Get Budget Hierarchy", diags, err)
+		return
+	}
+	data.RolledUpPeriods = rolledUpPeriodsValue(ctx, rollUpBudgetPeriods(nodes), diags)
+}
+
 func (r *budgetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data budgetModel
+	var data budgetResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -64,7 +235,7 @@ func (r *budgetResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Save the planned periods value to preserve empty lists
 	plannedPeriods := data.Periods
 
-	params := budgetsv2.NewCreateBudgetParams().WithCreateBudget(toCreateModel(ctx, &resp.Diagnostics, data))
+	params := budgetsv2.NewCreateBudgetParams().WithCreateBudget(toCreateModel(ctx, &resp.Diagnostics, *data.budget()))
 	out, err := r.client.V2.Budgets.CreateBudget(params, r.client.Auth)
 
 	if err != nil {
@@ -77,11 +248,15 @@ func (r *budgetResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	tflog.Debug(ctx, "applyBudgetPayload create")
-	diag := applyBudgetPayload(ctx, false, out.Payload, &data)
+	diag := applyBudgetPayload(ctx, false, out.Payload, data.budget())
 	if diag.HasError() {
 		resp.Diagnostics.Append(diag...)
 		return
 	}
+	r.setRolledUpPeriods(ctx, out.Payload, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// If the plan had an explicit empty list for periods, preserve it
 	// This prevents inconsistent state when the API returns default periods
@@ -89,12 +264,18 @@ func (r *budgetResource) Create(ctx context.Context, req resource.CreateRequest,
 		data.Periods = plannedPeriods
 	}
 
+	// Periods generated from a schedule were already shown in the plan; keep
+	// them as planned and let Read pick up the API's formatting.
+	if !data.Schedule.IsNull() && !plannedPeriods.IsUnknown() {
+		data.Periods = plannedPeriods
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *budgetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data budgetModel
+	var data budgetResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -119,11 +300,15 @@ func (r *budgetResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 	tflog.Debug(ctx, "applyBudgetPayload read")
-	diag := applyBudgetPayload(ctx, false, out.Payload, &data)
+	diag := applyBudgetPayload(ctx, false, out.Payload, data.budget())
 	if diag.HasError() {
 		resp.Diagnostics.Append(diag...)
 		return
 	}
+	r.setRolledUpPeriods(ctx, out.Payload, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// If the previous state had an explicit empty list for periods, preserve it
 	// The API returns default periods even when empty was specified
@@ -140,7 +325,7 @@ func (r *budgetResource) ImportState(ctx context.Context, req resource.ImportSta
 }
 
 func (r *budgetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data budgetModel
+	var data budgetResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -152,7 +337,7 @@ func (r *budgetResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Save the planned periods value to preserve empty lists
 	plannedPeriods := data.Periods
 
-	params := budgetsv2.NewUpdateBudgetParams().WithUpdateBudget(toUpdateModel(ctx, &resp.Diagnostics, data)).WithBudgetToken(data.Token.ValueString())
+	params := budgetsv2.NewUpdateBudgetParams().WithUpdateBudget(toUpdateModel(ctx, &resp.Diagnostics, *data.budget())).WithBudgetToken(data.Token.ValueString())
 	out, err := r.client.V2.Budgets.UpdateBudget(params, r.client.Auth)
 
 	if err != nil {
@@ -164,11 +349,15 @@ func (r *budgetResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 	tflog.Debug(ctx, "applyBudgetPayload update")
-	diag := applyBudgetPayload(ctx, false, out.Payload, &data)
+	diag := applyBudgetPayload(ctx, false, out.Payload, data.budget())
 	if diag.HasError() {
 		resp.Diagnostics.Append(diag...)
 		return
 	}
+	r.setRolledUpPeriods(ctx, out.Payload, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// If the plan had an explicit empty list for periods, preserve it
 	// This prevents inconsistent state when the API returns default periods
@@ -176,12 +365,18 @@ func (r *budgetResource) Update(ctx context.Context, req resource.UpdateRequest,
 		data.Periods = plannedPeriods
 	}
 
+	// Periods generated from a schedule were already shown in the plan; keep
+	// them as planned and let Read pick up the API's formatting.
+	if !data.Schedule.IsNull() && !plannedPeriods.IsUnknown() {
+		data.Periods = plannedPeriods
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *budgetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data budgetModel
+	var data budgetResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -0,0 +1,220 @@
+package vantage
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// budgetScheduleMaxPeriods caps how many periods a schedule can generate.
+const budgetScheduleMaxPeriods = 120
+
+var budgetScheduleMonthRegexp = regexp.MustCompile(`^\d{4}-(0[1-9]|1[0-2])$`)
+
+var budgetPeriodAttrTypes = map[string]attr.Type{
+	"amount":   types.Float64Type,
+	"end_at":   types.StringType,
+	"start_at": types.StringType,
+}
+
+type budgetScheduleModel struct {
+	StartMonth       types.String  `tfsdk:"start_month"`
+	PeriodCount      types.Int64   `tfsdk:"period_count"`
+	Cadence          types.String  `tfsdk:"cadence"`
+	Amount           types.Float64 `tfsdk:"amount"`
+	GrowthPercentage types.Float64 `tfsdk:"growth_percentage"`
+	Overrides        types.Map     `tfsdk:"overrides"`
+}
+
+func budgetScheduleAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: "Generates `periods` from a recurring schedule instead of listing every period. The generated periods are shown in the plan. Conflicts with configuring `periods` directly.",
+		Attributes: map[string]schema.Attribute{
+			"start_month": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The month of the first period, formatted `YYYY-MM`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(budgetScheduleMonthRegexp, "must be formatted YYYY-MM"),
+				},
+			},
+			"period_count": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: fmt.Sprintf("The number of periods to generate, at most %d.", budgetScheduleMaxPeriods),
+				Validators: []validator.Int64{
+					int64validator.Between(1, budgetScheduleMaxPeriods),
+				},
+			},
+			"cadence": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The length of each period. One of `monthly`, `quarterly` or `yearly`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("monthly", "quarterly", "yearly"),
+				},
+			},
+			"amount": schema.Float64Attribute{
+				Required:            true,
+				MarkdownDescription: "The amount of the first period.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"growth_percentage": schema.Float64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The percentage each period's amount grows over the previous one, compounded. Amounts are rounded to cents. Defaults to 0.",
+			},
+			"overrides": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.Float64Type,
+				MarkdownDescription: "Amounts for specific periods, keyed by the period's start date (`YYYY-MM-DD`). Each key must be the start of a generated period.",
+			},
+		},
+	}
+}
+
+// budgetSchedulePeriod is one generated period.
+type budgetSchedulePeriod struct {
+	StartAt string
+	EndAt   string
+	Amount  float64
+}
+
+// budgetSchedule is the parsed form of budgetScheduleModel.
+type budgetSchedule struct {
+	Start            time.Time
+	PeriodCount      int
+	Cadence          string
+	Amount           float64
+	GrowthPercentage float64
+	Overrides        map[string]float64
+}
+
+// expand generates the schedule's periods. Each period ends on the start date
+// of the next one.
+func (s budgetSchedule) expand() ([]budgetSchedulePeriod, error) {
+	months := map[string]int{"monthly": 1, "quarterly": 3, "yearly": 12}[s.Cadence]
+	if months == 0 {
+		return nil, fmt.Errorf("unsupported cadence %q", s.Cadence)
+	}
+
+	periods := make([]budgetSchedulePeriod, 0, s.PeriodCount)
+	used := make(map[string]bool, len(s.Overrides))
+	for i := 0; i < s.PeriodCount; i++ {
+		start := s.Start.AddDate(0, i*months, 0)
+		end := s.Start.AddDate(0, (i+1)*months, 0)
+		startAt := start.Format(time.DateOnly)
+
+		amount := s.Amount * math.Pow(1+s.GrowthPercentage/100, float64(i))
+		amount = math.Round(amount*100) / 100
+		if override, ok := s.Overrides[startAt]; ok {
+			amount = override
+			used[startAt] = true
+		}
+
+		periods = append(periods, budgetSchedulePeriod{
+			StartAt: startAt,
+			EndAt:   end.Format(time.DateOnly),
+			Amount:  amount,
+		})
+	}
+
+	for key := range s.Overrides {
+		if !used[key] {
+			return nil, fmt.Errorf("override %q is not the start date of a generated period", key)
+		}
+	}
+	return periods, nil
+}
+
+// parseBudgetSchedule converts a known schedule object into a budgetSchedule.
+func parseBudgetSchedule(ctx context.Context, m budgetScheduleModel, diags *diag.Diagnostics) budgetSchedule {
+	start, err := time.Parse("2006-01", m.StartMonth.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("schedule").AtName("start_month"), "Invalid Start Month", fmt.Sprintf("%q must be formatted YYYY-MM.", m.StartMonth.ValueString()))
+	}
+
+	overrides := map[string]float64{}
+	if !m.Overrides.IsNull() {
+		diags.Append(m.Overrides.ElementsAs(ctx, &overrides, false)...)
+	}
+
+	return budgetSchedule{
+		Start:            start,
+		PeriodCount:      int(m.PeriodCount.ValueInt64()),
+		Cadence:          m.Cadence.ValueString(),
+		Amount:           m.Amount.ValueFloat64(),
+		GrowthPercentage: m.GrowthPercentage.ValueFloat64(),
+		Overrides:        overrides,
+	}
+}
+
+// budgetSchedulePeriods expands a configured schedule into the value of
+// periods. statePeriods are the periods currently in state: where a
+// generated period matches one by start date and amount, the stored end date
+// is kept so a differently formatted end date from the API doesn't show as a
+// change. The result is unknown if any part of the schedule is unknown.
+func budgetSchedulePeriods(ctx context.Context, schedule types.Object, statePeriods types.List, diags *diag.Diagnostics) types.List {
+	elemType := types.ObjectType{AttrTypes: budgetPeriodAttrTypes}
+	if schedule.IsUnknown() {
+		return types.ListUnknown(elemType)
+	}
+
+	var m budgetScheduleModel
+	diags.Append(schedule.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return types.ListUnknown(elemType)
+	}
+	if m.StartMonth.IsUnknown() || m.PeriodCount.IsUnknown() || m.Cadence.IsUnknown() ||
+		m.Amount.IsUnknown() || m.GrowthPercentage.IsUnknown() || m.Overrides.IsUnknown() {
+		return types.ListUnknown(elemType)
+	}
+
+	parsed := parseBudgetSchedule(ctx, m, diags)
+	if diags.HasError() {
+		return types.ListUnknown(elemType)
+	}
+	generated, err := parsed.expand()
+	if err != nil {
+		diags.AddAttributeError(path.Root("schedule"), "Invalid Budget Schedule", err.Error())
+		return types.ListUnknown(elemType)
+	}
+
+	existing := map[string]budgetPeriodResourceModel{}
+	if !statePeriods.IsNull() && !statePeriods.IsUnknown() {
+		var stored []budgetPeriodResourceModel
+		diags.Append(statePeriods.ElementsAs(ctx, &stored, false)...)
+		for _, p := range stored {
+			existing[p.StartAt.ValueString()] = p
+		}
+	}
+
+	periods := make([]budgetPeriodResourceModel, 0, len(generated))
+	for _, g := range generated {
+		endAt := types.StringValue(g.EndAt)
+		if prior, ok := existing[g.StartAt]; ok && prior.Amount.ValueFloat64() == g.Amount && !prior.EndAt.IsNull() {
+			endAt = prior.EndAt
+		}
+		periods = append(periods, budgetPeriodResourceModel{
+			Amount:  types.Float64Value(g.Amount),
+			EndAt:   endAt,
+			StartAt: types.StringValue(g.StartAt),
+		})
+	}
+
+	l, d := types.ListValueFrom(ctx, elemType, periods)
+	diags.Append(d...)
+	return l
+}
@@ -0,0 +1,113 @@
+package vantage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBudgetScheduleExpand_monthlyGrowth(t *testing.T) {
+	s := budgetSchedule{
+		Start:            time.Date(2024, time.November, 1, 0, 0, 0, 0, time.UTC),
+		PeriodCount:      3,
+		Cadence:          "monthly",
+		Amount:           1000,
+		GrowthPercentage: 10,
+	}
+
+	got, err := s.expand()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []budgetSchedulePeriod{
+		{StartAt: "2024-11-01", EndAt: "2024-12-01", Amount: 1000},
+		{StartAt: "2024-12-01", EndAt: "2025-01-01", Amount: 1100},
+		{StartAt: "2025-01-01", EndAt: "2025-02-01", Amount: 1210},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d periods, got %d", len(want), len(got))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("period %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestBudgetScheduleExpand_quarterlyOverrides(t *testing.T) {
+	s := budgetSchedule{
+		Start:       time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+		PeriodCount: 4,
+		Cadence:     "quarterly",
+		Amount:      3000,
+		Overrides:   map[string]float64{"2025-10-01": 4500},
+	}
+
+	got, err := s.expand()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got[1].StartAt != "2025-04-01" || got[1].EndAt != "2025-07-01" {
+		t.Errorf("unexpected second quarter %+v", got[1])
+	}
+	if got[3].Amount != 4500 {
+		t.Errorf("expected override amount 4500 for the last quarter, got %v", got[3].Amount)
+	}
+
+	s.Overrides = map[string]float64{"2025-02-01": 1}
+	if _, err := s.expand(); err == nil {
+		t.Error("expected an error for an override that is not a period start")
+	}
+}
+
+func TestBudgetSchedulePeriods_keepsStateEndDate(t *testing.T) {
+	ctx := context.Background()
+	schedule := types.ObjectValueMust(
+		map[string]attr.Type{
+			"start_month":       types.StringType,
+			"period_count":      types.Int64Type,
+			"cadence":           types.StringType,
+			"amount":            types.Float64Type,
+			"growth_percentage": types.Float64Type,
+			"overrides":         types.MapType{ElemType: types.Float64Type},
+		},
+		map[string]attr.Value{
+			"start_month":       types.StringValue("2025-01"),
+			"period_count":      types.Int64Value(2),
+			"cadence":           types.StringValue("monthly"),
+			"amount":            types.Float64Value(500),
+			"growth_percentage": types.Float64Null(),
+			"overrides":         types.MapNull(types.Float64Type),
+		},
+	)
+	elemType := types.ObjectType{AttrTypes: budgetPeriodAttrTypes}
+	state := types.ListValueMust(elemType, []attr.Value{
+		types.ObjectValueMust(budgetPeriodAttrTypes, map[string]attr.Value{
+			"amount":   types.Float64Value(500),
+			"end_at":   types.StringValue("2025-01-31"),
+			"start_at": types.StringValue("2025-01-01"),
+		}),
+	})
+
+	var diags diag.Diagnostics
+	got := budgetSchedulePeriods(ctx, schedule, state, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var periods []budgetPeriodResourceModel
+	diags.Append(got.ElementsAs(ctx, &periods, false)...)
+	if len(periods) != 2 {
+		t.Fatalf("expected 2 periods, got %d", len(periods))
+	}
+	if periods[0].EndAt.ValueString() != "2025-01-31" {
+		t.Errorf("expected end date kept from state, got %s", periods[0].EndAt)
+	}
+	if periods[1].EndAt.ValueString() != "2025-03-01" {
+		t.Errorf("expected generated end date for a new period, got %s", periods[1].EndAt)
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/vantage-sh/terraform-provider-vantage/vantage/datasource_budgets"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
 	budgetsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/budgets"
 )
 
@@ -20,7 +22,12 @@ type budgetsDataSource struct {
 }
 
 type budgetsDataSourceModel struct {
-	Budgets []budgetModel `tfsdk:"budgets"`
+	WorkspaceToken types.String  `tfsdk:"workspace_token"`
+	NameRegex      types.String  `tfsdk:"name_regex"`
+	CreatedByToken types.String  `tfsdk:"created_by_token"`
+	CreatedAfter   types.String  `tfsdk:"created_after"`
+	CreatedBefore  types.String  `tfsdk:"created_before"`
+	Budgets        []budgetModel `tfsdk:"budgets"`
 }
 
 func (d *budgetsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -34,7 +41,12 @@ func (d *budgetsDataSource) Metadata(ctx context.Context, req datasource.Metadat
 }
 
 func (d *budgetsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = datasource_budgets.BudgetsDataSourceSchema(ctx)
+	s := datasource_budgets.BudgetsDataSourceSchema(ctx)
+	for name, attr := range listFilterAttributes("Budgets", "name_regex",
+		"workspace_token", "name_regex", "created_by_token", "created_after", "created_before") {
+		s.Attributes[name] = attr
+	}
+	resp.Schema = s
 }
 
 func (d *budgetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -47,8 +59,28 @@ func (d *budgetsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	params := budgetsv2.NewGetBudgetsParams()
-	out, err := d.client.V2.Budgets.GetBudgets(params, d.client.Auth)
+	filter := parseListFilter(listFilterConfig{
+		WorkspaceToken: data.WorkspaceToken,
+		TitleRegex:     data.NameRegex,
+		CreatedByToken: data.CreatedByToken,
+		CreatedAfter:   data.CreatedAfter,
+		CreatedBefore:  data.CreatedBefore,
+	}, "name_regex", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The endpoint has no filter parameters, so filters are applied here.
+	all, err := fetchAllPages(func(limit int32, page *int32) ([]*modelsv2.Budget, *modelsv2.Links, error) {
+		params := budgetsv2.NewGetBudgetsParams()
+		params.SetLimit(&limit)
+		params.SetPage(page)
+		out, err := d.client.V2.Budgets.GetBudgets(params, d.client.Auth)
+		if err != nil {
+			return nil, nil, err
+		}
+		return out.Payload.Budgets, out.Payload.Links, nil
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Get Vantage Budgets",
@@ -57,7 +89,19 @@ func (d *budgetsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 	budgets := []budgetModel{}
-	for _, budget := range out.Payload.Budgets {
+	for _, budget := range all {
+		var name []string
+		if budget.Name != nil {
+			name = []string{*budget.Name}
+		}
+		if !filter.Matches(listFilterItem{
+			WorkspaceTokens: []string{budget.WorkspaceToken},
+			Titles:          name,
+			CreatedByToken:  budget.CreatedByToken,
+			CreatedAt:       budget.CreatedAt,
+		}) {
+			continue
+		}
 		model := budgetModel{}
 		diag := applyBudgetPayload(ctx, true, budget, &model)
 		if diag.HasError() {
@@ -0,0 +1,194 @@
+package vantage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	businessmetricsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/business_metrics"
+	integrationsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/integrations"
+)
+
+// businessMetricImportPollInterval is how often waitForBusinessMetricImport
+// checks the metric. It is a variable so tests can shorten it.
+var businessMetricImportPollInterval = 15 * time.Second
+
+const defaultBusinessMetricImportTimeout = "10m"
+
+var errBusinessMetricImportTimeout = errors.New("timed out waiting for the business metric import")
+
+// businessMetricImportSources maps the attributes that import a metric's
+// values from an integration to the provider of that integration.
+var businessMetricImportSources = []struct {
+	Attribute string
+	Provider  string
+}{
+	{"cloudwatch_fields", "aws"},
+	{"datadog_metric_fields", "datadog"},
+	{"snowflake_metric_fields", "snowflake"},
+}
+
+func businessMetricImportAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"wait_for_import": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+			MarkdownDescription: "Whether create waits for the first import from `cloudwatch_fields`, `datadog_metric_fields` or `snowflake_metric_fields` to finish. A failed import fails the apply and taints the resource. Defaults to `false`.",
+		},
+		"import_timeout": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(defaultBusinessMetricImportTimeout),
+			MarkdownDescription: "How long to wait for the first import when `wait_for_import` is true, as a duration such as `30m`. An import still running after the timeout is reported as a warning. Defaults to `10m`.",
+		},
+		"import_status": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The status of the latest import from the integration.",
+		},
+		"last_imported_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "When values were last imported from the integration.",
+		},
+		"import_error": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The error of the latest import, if it failed.",
+		},
+	}
+}
+
+// businessMetricImportSettled reports whether an import has finished,
+// successfully or not.
+func businessMetricImportSettled(status string) bool {
+	switch status {
+	case "", "pending", "queued", "importing":
+		return false
+	}
+	return true
+}
+
+// businessMetricImportFailed reports whether a settled status means the
+// import failed.
+func businessMetricImportFailed(status string) bool {
+	return status == "error" || status == "failed"
+}
+
+// waitForBusinessMetricImport polls GetBusinessMetric until the import status
+// settles (see businessMetricImportSettled) and returns the last metric read.
+// If the status has not settled within timeout, the last metric is returned
+// together with errBusinessMetricImportTimeout.
+func waitForBusinessMetricImport(ctx context.Context, client *Client, token string, timeout time.Duration) (*modelsv2.BusinessMetric, error) {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		params := businessmetricsv2.NewGetBusinessMetricParams().WithBusinessMetricToken(token)
+		out, err := client.V2.BusinessMetrics.GetBusinessMetric(params, client.Auth)
+		if err != nil {
+			return nil, err
+		}
+
+		if businessMetricImportSettled(stringValue(out.Payload.ImportStatus)) {
+			return out.Payload, nil
+		}
+		if time.Now().Add(businessMetricImportPollInterval).After(deadline) {
+			return out.Payload, errBusinessMetricImportTimeout
+		}
+
+		select {
+		case <-ctx.Done():
+			return out.Payload, ctx.Err()
+		case <-time.After(businessMetricImportPollInterval):
+		}
+	}
+}
+
+// awaitBusinessMetricImport waits for the first import of a new metric and
+// records the outcome in diags: an error if the import failed, a warning if
+// it is still running after timeout. It returns the last metric read, or nil
+// if none could be read.
+func awaitBusinessMetricImport(ctx context.Context, client *Client, token string, timeout time.Duration, diags *diag.Diagnostics) *modelsv2.BusinessMetric {
+	metric, err := waitForBusinessMetricImport(ctx, client, token, timeout)
+	switch {
+	case errors.Is(err, errBusinessMetricImportTimeout):
+		diags.AddWarning(
+			"Business Metric Import Not Finished",
+			fmt.Sprintf("Business metric %s was created but its import is still %q after %s. Its import status will be refreshed on the next plan.", token, stringValue(metric.ImportStatus), timeout),
+		)
+		return metric
+	case err != nil:
+		handleError("Get Business Metric", diags, err)
+		return metric
+	}
+
+	if status := stringValue(metric.ImportStatus); businessMetricImportFailed(status) {
+		diags.AddError(
+			"Business Metric Import Failed",
+			fmt.Sprintf("Business metric %s was created but its import is %q: %s. Check the query and the integration; the resource is marked as tainted and will be replaced on the next apply.", token, status, stringValue(metric.ImportError)),
+		)
+	}
+	return metric
+}
+
+// applyImportStatus sets the computed import attributes from payload.
+func (d *businessMetricResourceData) applyImportStatus(payload *modelsv2.BusinessMetric) {
+	d.ImportStatus = types.StringPointerValue(payload.ImportStatus)
+	d.LastImportedAt = types.StringPointerValue(payload.LastImportedAt)
+	d.ImportError = types.StringPointerValue(payload.ImportError)
+}
+
+// importSource returns the configured import source attribute and its
+// integration token, or an empty attribute when the metric isn't imported.
+func (d *businessMetricResourceData) importSource() (string, types.String) {
+	switch {
+	case !d.CloudwatchFields.IsNull():
+		return "cloudwatch_fields", d.CloudwatchFields.IntegrationToken
+	case !d.DatadogMetricFields.IsNull():
+		return "datadog_metric_fields", d.DatadogMetricFields.IntegrationToken
+	case !d.SnowflakeMetricFields.IsNull():
+		return "snowflake_metric_fields", d.SnowflakeMetricFields.IntegrationToken
+	}
+	return "", types.StringNull()
+}
+
+// validateBusinessMetricIntegration checks that the integration of an import
+// source exists and belongs to the provider the source imports from.
+func validateBusinessMetricIntegration(client *Client, source, integrationToken string, diags *diag.Diagnostics) {
+	var provider string
+	for _, s := range businessMetricImportSources {
+		if s.Attribute == source {
+			provider = s.Provider
+		}
+	}
+
+	attrPath := path.Root(source).AtName("integration_token")
+	params := integrationsv2.NewGetIntegrationParams()
+	params.SetIntegrationToken(integrationToken)
+	out, err := client.V2.Integrations.GetIntegration(params, client.Auth)
+	if err != nil {
+		if _, ok := err.(*integrationsv2.GetIntegrationNotFound); ok {
+			diags.AddAttributeError(attrPath, "Integration Not Found", fmt.Sprintf("No integration with token %q was found.", integrationToken))
+			return
+		}
+		handleError("Get Integration", diags, err)
+		return
+	}
+	if out.Payload.Provider != provider {
+		diags.AddAttributeError(attrPath, "Wrong Integration Provider",
+			fmt.Sprintf("%s imports from a %s integration, but integration %q is a %s integration.", source, provider, integrationToken, out.Payload.Provider))
+	}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
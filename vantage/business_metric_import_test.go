@@ -0,0 +1,126 @@
+package vantage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// newMockBusinessMetricImportServer serves GET /v2/business_metrics/bsnss_mtrc_1,
+// returning the given import statuses in order and repeating the last one.
+func newMockBusinessMetricImportServer(t *testing.T, statuses ...string) (*httptest.Server, *int) {
+	t.Helper()
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/business_metrics/bsnss_mtrc_1" {
+			http.NotFound(w, r)
+			return
+		}
+		status := statuses[len(statuses)-1]
+		if calls < len(statuses) {
+			status = statuses[calls]
+		}
+		calls++
+		metric := map[string]any{
+			"token":         "bsnss_mtrc_1",
+			"title":         "Requests",
+			"import_status": status,
+		}
+		if status == "failed" {
+			metric["import_error"] = "query returned no rows"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(metric)
+	}))
+	return srv, &calls
+}
+
+func shortenBusinessMetricImportPollInterval(t *testing.T) {
+	t.Helper()
+	prev := businessMetricImportPollInterval
+	businessMetricImportPollInterval = time.Millisecond
+	t.Cleanup(func() { businessMetricImportPollInterval = prev })
+}
+
+func TestWaitForBusinessMetricImport_pollsUntilImported(t *testing.T) {
+	shortenBusinessMetricImportPollInterval(t)
+	srv, calls := newMockBusinessMetricImportServer(t, "pending", "importing", "imported")
+	defer srv.Close()
+
+	got, err := waitForBusinessMetricImport(context.Background(), clientForServer(t, srv.URL), "bsnss_mtrc_1", time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stringValue(got.ImportStatus) != "imported" {
+		t.Errorf("got status %q, want %q", stringValue(got.ImportStatus), "imported")
+	}
+	if *calls != 3 {
+		t.Errorf("got %d requests, want 3", *calls)
+	}
+}
+
+func TestWaitForBusinessMetricImport_timesOut(t *testing.T) {
+	shortenBusinessMetricImportPollInterval(t)
+	srv, _ := newMockBusinessMetricImportServer(t, "importing")
+	defer srv.Close()
+
+	got, err := waitForBusinessMetricImport(context.Background(), clientForServer(t, srv.URL), "bsnss_mtrc_1", 0)
+	if !errors.Is(err, errBusinessMetricImportTimeout) {
+		t.Fatalf("got error %v, want errBusinessMetricImportTimeout", err)
+	}
+	if got == nil || stringValue(got.ImportStatus) != "importing" {
+		t.Errorf("expected the last metric read, got %+v", got)
+	}
+}
+
+func TestAwaitBusinessMetricImport_failedImportIsAnError(t *testing.T) {
+	shortenBusinessMetricImportPollInterval(t)
+	srv, _ := newMockBusinessMetricImportServer(t, "pending", "failed")
+	defer srv.Close()
+
+	var diags diag.Diagnostics
+	awaitBusinessMetricImport(context.Background(), clientForServer(t, srv.URL), "bsnss_mtrc_1", time.Minute, &diags)
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "query returned no rows") {
+		t.Errorf("expected an error with the import error, got %v", diags)
+	}
+}
+
+func TestValidateBusinessMetricIntegration(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/integrations/accss_crdntl_1" {
+			http.NotFound(w, r)
+			return
+		}
+		integration := mockIntegration("accss_crdntl_1", "datadog-account")
+		integration.Provider = "datadog"
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(integration)
+	}))
+	defer srv.Close()
+	client := clientForServer(t, srv.URL)
+
+	var diags diag.Diagnostics
+	validateBusinessMetricIntegration(client, "datadog_metric_fields", "accss_crdntl_1", &diags)
+	if diags.HasError() {
+		t.Errorf("expected a datadog integration to be accepted, got %v", diags)
+	}
+
+	diags = nil
+	validateBusinessMetricIntegration(client, "snowflake_metric_fields", "accss_crdntl_1", &diags)
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Wrong Integration Provider" {
+		t.Errorf("expected a provider mismatch, got %v", diags)
+	}
+
+	diags = nil
+	validateBusinessMetricIntegration(client, "cloudwatch_fields", "accss_crdntl_2", &diags)
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Integration Not Found" {
+		t.Errorf("expected a missing integration, got %v", diags)
+	}
+}
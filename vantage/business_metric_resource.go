@@ -2,7 +2,10 @@ package vantage
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -11,15 +14,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/vantage-sh/terraform-provider-vantage/vantage/resource_business_metric"
 	businessmetricsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/business_metrics"
 )
 
 var (
-	_ resource.Resource                = (*businessMetricResource)(nil)
-	_ resource.ResourceWithConfigure   = (*businessMetricResource)(nil)
-	_ resource.ResourceWithImportState = (*businessMetricResource)(nil)
+	_ resource.Resource                     = (*businessMetricResource)(nil)
+	_ resource.ResourceWithConfigure        = (*businessMetricResource)(nil)
+	_ resource.ResourceWithImportState      = (*businessMetricResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*businessMetricResource)(nil)
+	_ resource.ResourceWithValidateConfig   = (*businessMetricResource)(nil)
+	_ resource.ResourceWithModifyPlan       = (*businessMetricResource)(nil)
 )
 
 func NewBusinessMetricResource() resource.Resource {
@@ -30,6 +37,24 @@ type businessMetricResource struct {
 	client *Client
 }
 
+// businessMetricResourceData is the state of a vantage_business_metric: the
+// generated model plus the attributes added to its schema.
+type businessMetricResourceData struct {
+	businessMetricResourceModel
+	ValuesCsv      types.String `tfsdk:"values_csv"`
+	ValuesSha256   types.String `tfsdk:"values_sha256"`
+	ValuesSummary  types.Object `tfsdk:"values_summary"`
+	WaitForImport  types.Bool   `tfsdk:"wait_for_import"`
+	ImportTimeout  types.String `tfsdk:"import_timeout"`
+	ImportStatus   types.String `tfsdk:"import_status"`
+	LastImportedAt types.String `tfsdk:"last_imported_at"`
+	ImportError    types.String `tfsdk:"import_error"`
+}
+
+func (d *businessMetricResourceData) metric() *businessMetricResourceModel {
+	return &d.businessMetricResourceModel
+}
+
 // Configure implements resource.ResourceWithConfigure.
 func (r *businessMetricResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -56,10 +81,131 @@ func (r *businessMetricResource) Schema(ctx context.Context, req resource.Schema
 	applyEmptyLabelDefault(s.Attributes, "values")
 	applyEmptyLabelDefault(s.Attributes, "forecasted_values")
 	applyCostReportTokenMetadataDefaults(s.Attributes)
+	for name, a := range businessMetricValuesCsvAttributes() {
+		s.Attributes[name] = a
+	}
+	for name, a := range businessMetricImportAttributes() {
+		s.Attributes[name] = a
+	}
 
 	resp.Schema = s
 }
 
+func (r *businessMetricResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("values"),
+			path.MatchRoot("values_csv"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("cloudwatch_fields"),
+			path.MatchRoot("datadog_metric_fields"),
+			path.MatchRoot("snowflake_metric_fields"),
+		),
+	}
+}
+
+func (r *businessMetricResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data businessMetricResourceData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ValuesCsv.IsNull() && !data.ValuesCsv.IsUnknown() {
+		if _, err := parseBusinessMetricValuesCsv(data.ValuesCsv.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("values_csv"), "Invalid Business Metric Values CSV", err.Error())
+		}
+	}
+
+	if v := data.ImportTimeout.ValueString(); v != "" {
+		if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("import_timeout"), "Invalid Import Timeout",
+				fmt.Sprintf("%q must be a positive duration such as \"30m\".", v))
+		}
+	}
+
+	if data.WaitForImport.ValueBool() {
+		if source, _ := data.importSource(); source == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("wait_for_import"), "Missing Import Source",
+				"wait_for_import requires one of cloudwatch_fields, datadog_metric_fields or snowflake_metric_fields.")
+		}
+	}
+}
+
+// ModifyPlan plans values_sha256 and values_summary from values_csv, which is
+// write-only and so only available in the config, and checks that a new or
+// changed import source references an integration of its provider.
+func (r *businessMetricResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	r.modifyPlanValuesCsv(ctx, req, resp)
+	r.modifyPlanImportSource(ctx, req, resp)
+}
+
+func (r *businessMetricResource) modifyPlanValuesCsv(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var data businessMetricResourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	var content types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("values_csv"), &content)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planBusinessMetricValuesCsv(content, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("values_sha256"), data.ValuesSha256)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("values_summary"), data.ValuesSummary)...)
+}
+
+func (r *businessMetricResource) modifyPlanImportSource(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	var plan businessMetricResourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	source, integrationToken := plan.importSource()
+	if source == "" || integrationToken.IsNull() || integrationToken.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state businessMetricResourceData
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if stateSource, stateToken := state.importSource(); stateSource == source && stateToken.Equal(integrationToken) {
+			return
+		}
+	}
+
+	validateBusinessMetricIntegration(r.client, source, integrationToken.ValueString(), &resp.Diagnostics)
+}
+
+// configuredValuesCsv returns the parsed values_csv of config, or nil when it
+// isn't set.
+func configuredValuesCsv(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) []businessMetricCsvValue {
+	var content types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("values_csv"), &content)...)
+	if diags.HasError() || content.IsNull() || content.IsUnknown() {
+		return nil
+	}
+	values, err := parseBusinessMetricValuesCsv(content.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("values_csv"), "Invalid Business Metric Values CSV", err.Error())
+		return nil
+	}
+	return values
+}
+
 func applyEmptyLabelDefault(attrs map[string]schema.Attribute, attrName string) {
 	attr, ok := attrs[attrName].(schema.ListNestedAttribute)
 	if !ok {
@@ -95,7 +241,7 @@ func applyCostReportTokenMetadataDefaults(attrs map[string]schema.Attribute) {
 }
 
 func (r *businessMetricResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data *businessMetricResourceModel
+	var data *businessMetricResourceData
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -126,6 +272,7 @@ func (r *businessMetricResource) Create(ctx context.Context, req resource.Create
 		resp.Diagnostics.Append(diag...)
 		return
 	}
+	data.applyImportStatus(out.Payload)
 
 	attrTypes := map[string]attr.Type{
 		"amount": types.Float64Type,
@@ -136,18 +283,34 @@ func (r *businessMetricResource) Create(ctx context.Context, req resource.Create
 	if oldValues.IsUnknown() {
 		data.Values = types.ListNull(types.ObjectType{AttrTypes: attrTypes})
 	} else {
-		assignValues(ctx, data, oldValues, &resp.Diagnostics)
+		assignValues(ctx, data.metric(), oldValues, &resp.Diagnostics)
 	}
 
 	if oldForecastedValues.IsUnknown() {
 		data.ForecastedValues = types.ListNull(types.ObjectType{AttrTypes: attrTypes})
 	} else {
-		assignForecastedValues(ctx, data, oldForecastedValues, &resp.Diagnostics)
+		assignForecastedValues(ctx, data.metric(), oldForecastedValues, &resp.Diagnostics)
 	}
 
 	// Preserve the original order of cost report tokens from the plan
 	if !oldCostReportTokens.IsNull() && !oldCostReportTokens.IsUnknown() {
-		assignCostReportTokens(ctx, data, oldCostReportTokens, &resp.Diagnostics)
+		assignCostReportTokens(ctx, data.metric(), oldCostReportTokens, &resp.Diagnostics)
+	}
+
+	if values := configuredValuesCsv(ctx, req.Config, &resp.Diagnostics); len(values) > 0 {
+		r.syncBusinessMetricValuesCsv(data.Token.ValueString(), values, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			// The metric was created, so save it; the failed upload taints it.
+			data.ValuesSha256 = types.StringNull()
+		}
+	}
+
+	if source, _ := data.importSource(); source != "" && data.WaitForImport.ValueBool() && !resp.Diagnostics.HasError() {
+		// ValidateConfig has checked the timeout.
+		timeout, _ := time.ParseDuration(data.ImportTimeout.ValueString())
+		if metric := awaitBusinessMetricImport(ctx, r.client, data.Token.ValueString(), timeout, &resp.Diagnostics); metric != nil {
+			data.applyImportStatus(metric)
+		}
 	}
 
 	// Save data into Terraform state
@@ -231,7 +394,7 @@ func assignForecastedValues(ctx context.Context, data *businessMetricResourceMod
 }
 
 func (r *businessMetricResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data *businessMetricResourceModel
+	var data *businessMetricResourceData
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -259,10 +422,18 @@ func (r *businessMetricResource) Read(ctx context.Context, req resource.ReadRequ
 		resp.Diagnostics.Append(diag...)
 		return
 	}
+	data.applyImportStatus(out.Payload)
+	// Imported resources have no configured defaults in state yet.
+	if data.WaitForImport.IsNull() {
+		data.WaitForImport = types.BoolValue(false)
+	}
+	if data.ImportTimeout.IsNull() {
+		data.ImportTimeout = types.StringValue(defaultBusinessMetricImportTimeout)
+	}
 
 	// Preserve the original order of cost report tokens from state
 	if !oldCostReportTokens.IsNull() && !oldCostReportTokens.IsUnknown() {
-		assignCostReportTokens(ctx, data, oldCostReportTokens, &resp.Diagnostics)
+		assignCostReportTokens(ctx, data.metric(), oldCostReportTokens, &resp.Diagnostics)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -273,8 +444,10 @@ func (r *businessMetricResource) ImportState(ctx context.Context, req resource.I
 }
 
 func (r *businessMetricResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data *businessMetricResourceModel
+	var data *businessMetricResourceData
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	var stateSha256 types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("values_sha256"), &stateSha256)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -305,6 +478,18 @@ func (r *businessMetricResource) Update(ctx context.Context, req resource.Update
 		resp.Diagnostics.Append(diag...)
 		return
 	}
+	data.applyImportStatus(out.Payload)
+
+	// Values are only synced when the CSV changed, so an unchanged CSV doesn't
+	// fetch every value on each update.
+	if !data.ValuesSha256.IsNull() && !data.ValuesSha256.Equal(stateSha256) {
+		if values := configuredValuesCsv(ctx, req.Config, &resp.Diagnostics); values != nil {
+			r.syncBusinessMetricValuesCsv(data.Token.ValueString(), values, &resp.Diagnostics)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	attrTypes := map[string]attr.Type{
 		"amount": types.Float64Type,
@@ -315,25 +500,25 @@ func (r *businessMetricResource) Update(ctx context.Context, req resource.Update
 	if oldValues.IsUnknown() {
 		data.Values = types.ListNull(types.ObjectType{AttrTypes: attrTypes})
 	} else {
-		assignValues(ctx, data, oldValues, &resp.Diagnostics)
+		assignValues(ctx, data.metric(), oldValues, &resp.Diagnostics)
 	}
 
 	if oldForecastedValues.IsUnknown() {
 		data.ForecastedValues = types.ListNull(types.ObjectType{AttrTypes: attrTypes})
 	} else {
-		assignForecastedValues(ctx, data, oldForecastedValues, &resp.Diagnostics)
+		assignForecastedValues(ctx, data.metric(), oldForecastedValues, &resp.Diagnostics)
 	}
 
 	// Preserve the original order of cost report tokens from the plan
 	if !oldCostReportTokens.IsNull() && !oldCostReportTokens.IsUnknown() {
-		assignCostReportTokens(ctx, data, oldCostReportTokens, &resp.Diagnostics)
+		assignCostReportTokens(ctx, data.metric(), oldCostReportTokens, &resp.Diagnostics)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *businessMetricResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data *businessMetricResourceModel
+	var data *businessMetricResourceData
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
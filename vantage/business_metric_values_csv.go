@@ -0,0 +1,311 @@
+package vantage
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	businessmetricsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/business_metrics"
+)
+
+func businessMetricValuesCsvAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"values_csv": schema.StringAttribute{
+			Optional:  true,
+			WriteOnly: true,
+			MarkdownDescription: "The values of the BusinessMetric as CSV content with the header `date,amount,label`, e.g. read with `file()`. " +
+				"Dates are formatted `YYYY-MM-DD` and `label` may be empty or omitted. Each date and label pair may appear once. " +
+				"Values are uploaded through the CSV import, and on update only rows whose amount changed or that were added are sent; when rows are removed every row is sent so the removed values are deleted. " +
+				"The content is write-only and not kept in state: changes are detected with `values_sha256`. Requires Terraform 1.11 or later. Conflicts with `values`.",
+		},
+		"values_sha256": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The hex-encoded SHA-256 of `values_csv`, matching `filesha256()` of the file it was read from.",
+		},
+		"values_summary": schema.SingleNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "A summary of the values loaded from `values_csv`, kept in state in place of the values.",
+			Attributes: map[string]schema.Attribute{
+				"row_count": schema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "The number of values.",
+				},
+				"start_date": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The earliest date of the values.",
+				},
+				"end_date": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The latest date of the values.",
+				},
+				"label_count": schema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "The number of distinct labels, counting an empty label.",
+				},
+			},
+		},
+	}
+}
+
+func businessMetricValuesSummaryAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"row_count":   types.Int64Type,
+		"start_date":  types.StringType,
+		"end_date":    types.StringType,
+		"label_count": types.Int64Type,
+	}
+}
+
+// businessMetricCsvValue is one value of a values_csv.
+type businessMetricCsvValue struct {
+	Date   string
+	Amount float64
+	Label  string
+}
+
+func (v businessMetricCsvValue) key() string {
+	return v.Date + "\x00" + v.Label
+}
+
+// parseBusinessMetricValuesCsv parses and validates values_csv content. Errors
+// name the row they were found in, counting the header as row 1.
+func parseBusinessMetricValuesCsv(content string) ([]businessMetricCsvValue, error) {
+	r := csv.NewReader(strings.NewReader(content))
+	r.TrimLeadingSpace = true
+	r.FieldsPerRecord = -1
+
+	header, err := r.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, errors.New("the CSV content is empty; a header row is required")
+		}
+		return nil, err
+	}
+	columns := map[string]int{}
+	for i, h := range header {
+		name := strings.ToLower(strings.TrimSpace(h))
+		switch name {
+		case "date", "amount", "label":
+		default:
+			return nil, fmt.Errorf("unknown column %q; expected date, amount and label", h)
+		}
+		columns[name] = i
+	}
+	for _, required := range []string{"date", "amount"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("the header is missing the %q column", required)
+		}
+	}
+
+	cell := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var values []businessMetricCsvValue
+	seen := map[string]int{}
+	for line := 2; ; line++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			return values, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		v := businessMetricCsvValue{Date: cell(record, "date"), Label: cell(record, "label")}
+		if _, err := time.Parse(time.DateOnly, v.Date); err != nil {
+			return nil, fmt.Errorf("row %d: date %q must be formatted YYYY-MM-DD", line, v.Date)
+		}
+		v.Amount, err = strconv.ParseFloat(cell(record, "amount"), 64)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: invalid amount %q", line, cell(record, "amount"))
+		}
+		if prior, ok := seen[v.key()]; ok {
+			return nil, fmt.Errorf("row %d: duplicates the date %s and label %q of row %d", line, v.Date, v.Label, prior)
+		}
+		seen[v.key()] = line
+		values = append(values, v)
+	}
+}
+
+func businessMetricValuesSha256(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func businessMetricValuesSummary(values []businessMetricCsvValue) (types.Object, diag.Diagnostics) {
+	startDate, endDate := types.StringNull(), types.StringNull()
+	labels := map[string]bool{}
+	for _, v := range values {
+		if startDate.IsNull() || v.Date < startDate.ValueString() {
+			startDate = types.StringValue(v.Date)
+		}
+		if endDate.IsNull() || v.Date > endDate.ValueString() {
+			endDate = types.StringValue(v.Date)
+		}
+		labels[v.Label] = true
+	}
+	return types.ObjectValue(businessMetricValuesSummaryAttrTypes(), map[string]attr.Value{
+		"row_count":   types.Int64Value(int64(len(values))),
+		"start_date":  startDate,
+		"end_date":    endDate,
+		"label_count": types.Int64Value(int64(len(labels))),
+	})
+}
+
+// diffBusinessMetricValues compares the values of a values_csv with the
+// values of the metric. changed holds the values that are new or whose amount
+// differs; removed counts the current values missing from values.
+func diffBusinessMetricValues(current, values []businessMetricCsvValue) (changed []businessMetricCsvValue, removed int) {
+	existing := make(map[string]float64, len(current))
+	for _, v := range current {
+		existing[v.key()] = v.Amount
+	}
+	wanted := make(map[string]bool, len(values))
+	for _, v := range values {
+		wanted[v.key()] = true
+		amount, ok := existing[v.key()]
+		if !ok || math.Abs(amount-v.Amount) > 1e-9 {
+			changed = append(changed, v)
+		}
+	}
+	for _, v := range current {
+		if !wanted[v.key()] {
+			removed++
+		}
+	}
+	return changed, removed
+}
+
+func businessMetricValuesCsvContent(values []businessMetricCsvValue) string {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	_ = w.Write([]string{"date", "amount", "label"})
+	for _, v := range values {
+		_ = w.Write([]string{v.Date, strconv.FormatFloat(v.Amount, 'f', -1, 64), v.Label})
+	}
+	w.Flush()
+	return buf.String()
+}
+
+// syncBusinessMetricValuesCsv brings the values of the metric in line with
+// values. Added and changed values are uploaded as CSV. The CSV import can't
+// delete values, so when any are removed every value is sent with an update
+// instead, which replaces them.
+func (r *businessMetricResource) syncBusinessMetricValuesCsv(token string, values []businessMetricCsvValue, diags *diag.Diagnostics) {
+	current, err := r.businessMetricValues(token)
+	if err != nil {
+		handleError("Get Business Metric Values", diags, err)
+		return
+	}
+
+	changed, removed := diffBusinessMetricValues(current, values)
+	if removed > 0 {
+		model := &modelsv2.UpdateBusinessMetric{Values: make([]*modelsv2.UpdateBusinessMetricValuesItems0, 0, len(values))}
+		for _, v := range values {
+			amount := v.Amount
+			t, _ := time.Parse(time.DateOnly, v.Date)
+			date := strfmt.DateTime(t)
+			label := v.Label
+			model.Values = append(model.Values, &modelsv2.UpdateBusinessMetricValuesItems0{Amount: &amount, Date: &date, Label: &label})
+		}
+		params := businessmetricsv2.NewUpdateBusinessMetricParams().WithBusinessMetricToken(token).WithUpdateBusinessMetric(model)
+		if _, err := r.client.V2.BusinessMetrics.UpdateBusinessMetric(params, r.client.Auth); err != nil {
+			if e, ok := err.(*businessmetricsv2.UpdateBusinessMetricBadRequest); ok {
+				handleBadRequest("Update Business Metric Values", diags, e.GetPayload())
+				return
+			}
+			handleError("Update Business Metric Values", diags, err)
+		}
+		return
+	}
+	if len(changed) == 0 {
+		return
+	}
+
+	params := businessmetricsv2.NewUpdateBusinessMetricValuesCsvParams()
+	params.SetBusinessMetricToken(token)
+	params.SetCsv(runtime.NamedReader("values.csv", strings.NewReader(businessMetricValuesCsvContent(changed))))
+	if _, err := r.client.V2.BusinessMetrics.UpdateBusinessMetricValuesCsv(params, r.client.Auth, businessmetricsv2.WithContentTypeMultipartFormData); err != nil {
+		if e, ok := err.(*businessmetricsv2.UpdateBusinessMetricValuesCsvBadRequest); ok {
+			handleBadRequest("Upload Business Metric Values", diags, e.GetPayload())
+			return
+		}
+		handleError("Upload Business Metric Values", diags, err)
+	}
+}
+
+// businessMetricValues returns the values of the metric, sorted by date.
+func (r *businessMetricResource) businessMetricValues(token string) ([]businessMetricCsvValue, error) {
+	out, err := fetchAllPages(func(limit int32, page *int32) ([]*modelsv2.BusinessMetricValue, *modelsv2.Links, error) {
+		params := businessmetricsv2.NewGetBusinessMetricValuesParams().WithBusinessMetricToken(token)
+		params.SetLimit(&limit)
+		params.SetPage(page)
+		out, err := r.client.V2.BusinessMetrics.GetBusinessMetricValues(params, r.client.Auth)
+		if err != nil {
+			return nil, nil, err
+		}
+		return out.Payload.Values, out.Payload.Links, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]businessMetricCsvValue, 0, len(out))
+	for _, v := range out {
+		amount, err := strconv.ParseFloat(v.Amount, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing business metric value amount %q: %w", v.Amount, err)
+		}
+		date := v.Date
+		if len(date) > len(time.DateOnly) {
+			date = date[:len(time.DateOnly)]
+		}
+		values = append(values, businessMetricCsvValue{Date: date, Amount: amount, Label: v.Label})
+	}
+	sort.SliceStable(values, func(i, j int) bool { return values[i].Date < values[j].Date })
+	return values, nil
+}
+
+// planBusinessMetricValuesCsv sets the planned values_sha256 and
+// values_summary from the configured values_csv.
+func planBusinessMetricValuesCsv(content types.String, data *businessMetricResourceData, diags *diag.Diagnostics) {
+	switch {
+	case content.IsUnknown():
+		data.ValuesSha256 = types.StringUnknown()
+		data.ValuesSummary = types.ObjectUnknown(businessMetricValuesSummaryAttrTypes())
+	case content.IsNull():
+		data.ValuesSha256 = types.StringNull()
+		data.ValuesSummary = types.ObjectNull(businessMetricValuesSummaryAttrTypes())
+	default:
+		values, err := parseBusinessMetricValuesCsv(content.ValueString())
+		if err != nil {
+			// Reported by ValidateConfig.
+			return
+		}
+		summary, d := businessMetricValuesSummary(values)
+		diags.Append(d...)
+		data.ValuesSha256 = types.StringValue(businessMetricValuesSha256(content.ValueString()))
+		data.ValuesSummary = summary
+	}
+}
@@ -0,0 +1,100 @@
+package vantage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseBusinessMetricValuesCsv(t *testing.T) {
+	values, err := parseBusinessMetricValuesCsv("date,amount,label\n2025-01-01,10,web\n2025-01-01,5,\n2025-01-02, 12.5 ,web\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []businessMetricCsvValue{
+		{Date: "2025-01-01", Amount: 10, Label: "web"},
+		{Date: "2025-01-01", Amount: 5},
+		{Date: "2025-01-02", Amount: 12.5, Label: "web"},
+	}
+	if len(values) != len(want) {
+		t.Fatalf("expected %v, got %v", want, values)
+	}
+	for i := range want {
+		if values[i] != want[i] {
+			t.Errorf("row %d: expected %v, got %v", i, want[i], values[i])
+		}
+	}
+
+	// The label column is optional.
+	values, err = parseBusinessMetricValuesCsv("amount,date\n3,2025-01-01\n")
+	if err != nil || len(values) != 1 || values[0].Amount != 3 {
+		t.Errorf("expected one unlabeled value, got %v, %v", values, err)
+	}
+
+	for content, msg := range map[string]string{
+		"":                              "header row is required",
+		"date,amount,unit\n":            `unknown column "unit"`,
+		"date,label\n":                  `missing the "amount" column`,
+		"date,amount\n01/02/2025,1\n":   "row 2: date",
+		"date,amount\n2025-01-01,ten\n": "row 2: invalid amount",
+		"date,amount\n2025-01-01,1\n2025-01-01,2\n": "row 3: duplicates the date 2025-01-01",
+	} {
+		if _, err := parseBusinessMetricValuesCsv(content); err == nil || !strings.Contains(err.Error(), msg) {
+			t.Errorf("%q: expected an error containing %q, got %v", content, msg, err)
+		}
+	}
+}
+
+func TestDiffBusinessMetricValues(t *testing.T) {
+	current := []businessMetricCsvValue{
+		{Date: "2025-01-01", Amount: 10, Label: "web"},
+		{Date: "2025-01-02", Amount: 11, Label: "web"},
+	}
+
+	changed, removed := diffBusinessMetricValues(current, []businessMetricCsvValue{
+		{Date: "2025-01-01", Amount: 10, Label: "web"},
+		{Date: "2025-01-02", Amount: 12, Label: "web"},
+		{Date: "2025-01-03", Amount: 13, Label: "web"},
+	})
+	if removed != 0 || len(changed) != 2 || changed[0].Date != "2025-01-02" || changed[1].Date != "2025-01-03" {
+		t.Errorf("expected the changed and added values, got %v and %d removed", changed, removed)
+	}
+
+	changed, removed = diffBusinessMetricValues(current, []businessMetricCsvValue{
+		{Date: "2025-01-01", Amount: 10, Label: "web"},
+	})
+	if removed != 1 || len(changed) != 0 {
+		t.Errorf("expected one removed value, got %v and %d removed", changed, removed)
+	}
+}
+
+func TestBusinessMetricValuesSummary(t *testing.T) {
+	summary, diags := businessMetricValuesSummary([]businessMetricCsvValue{
+		{Date: "2025-01-02", Amount: 1, Label: "web"},
+		{Date: "2025-01-01", Amount: 1},
+		{Date: "2025-01-03", Amount: 1, Label: "web"},
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	attrs := summary.Attributes()
+	if attrs["row_count"] != types.Int64Value(3) || attrs["label_count"] != types.Int64Value(2) ||
+		attrs["start_date"] != types.StringValue("2025-01-01") || attrs["end_date"] != types.StringValue("2025-01-03") {
+		t.Errorf("unexpected summary %v", summary)
+	}
+}
+
+func TestBusinessMetricValuesCsvContentRoundTrip(t *testing.T) {
+	values := []businessMetricCsvValue{
+		{Date: "2025-01-01", Amount: 1.25, Label: "a,b"},
+		{Date: "2025-01-02", Amount: 2},
+	}
+	parsed, err := parseBusinessMetricValuesCsv(businessMetricValuesCsvContent(values))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parsed) != 2 || parsed[0] != values[0] || parsed[1] != values[1] {
+		t.Errorf("expected %v, got %v", values, parsed)
+	}
+}
@@ -0,0 +1,37 @@
+package vantage
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	integrationsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/integrations"
+)
+
+func NewConfluentIntegrationResource() resource.Resource {
+	return newSaasIntegrationResource(saasIntegrationSpec{
+		TypeName:    "confluent_integration",
+		DisplayName: "Confluent",
+		Description: "Manages a Confluent Cloud integration, which imports Confluent Cloud billing costs.",
+		Fields: []saasIntegrationField{
+			{Name: "api_key", Description: "The key of a Confluent Cloud API key owned by a user or service account with the BillingAdmin role."},
+			{Name: "api_secret", Description: "The secret of the Confluent Cloud API key.", Sensitive: true},
+		},
+		Create: func(client *Client, values map[string]*string) (*modelsv2.Integration, error) {
+			params := integrationsv2.NewCreateConfluentIntegrationParams()
+			params.WithCreateConfluentIntegration(&modelsv2.CreateConfluentIntegration{
+				APIKey:    values["api_key"],
+				APISecret: values["api_secret"],
+			})
+			out, err := client.V2.Integrations.CreateConfluentIntegration(params, client.Auth)
+			if err != nil {
+				return nil, err
+			}
+			return out.Payload, nil
+		},
+		BadRequest: func(err error) *modelsv2.Errors {
+			if e, ok := err.(*integrationsv2.CreateConfluentIntegrationBadRequest); ok {
+				return e.GetPayload()
+			}
+			return nil
+		},
+	})
+}
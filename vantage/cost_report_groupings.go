@@ -0,0 +1,187 @@
+package vantage
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.ResourceWithValidateConfig = (*CostReportResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*CostReportResource)(nil)
+)
+
+// costReportGroupingDimensions are the dimensions a Cost Report can be grouped
+// by, besides tags.
+var costReportGroupingDimensions = []string{
+	"account_id",
+	"billing_account_id",
+	"charge_type",
+	"cost_category",
+	"cost_subcategory",
+	"provider",
+	"region",
+	"resource_id",
+	"service",
+	"tagged",
+}
+
+var costReportTagGroupingRegexp = regexp.MustCompile(`^tag:\S.*$`)
+
+// costReportGroupingValidators validate a single grouping.
+func costReportGroupingValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.Any(
+			stringvalidator.OneOf(costReportGroupingDimensions...),
+			stringvalidator.RegexMatches(costReportTagGroupingRegexp, "must be tag:<key>"),
+		),
+	}
+}
+
+func costReportGroupingListValidators() []validator.List {
+	return []validator.List{
+		listvalidator.UniqueValues(),
+		listvalidator.ValueStringsAre(costReportGroupingValidators()...),
+	}
+}
+
+// validCostReportGrouping reports whether g is a supported grouping.
+func validCostReportGrouping(g string) bool {
+	return slices.Contains(costReportGroupingDimensions, g) || costReportTagGroupingRegexp.MatchString(g)
+}
+
+// splitCostReportGroupings splits the comma-separated groupings string the
+// API uses into its groupings.
+func splitCostReportGroupings(s string) []string {
+	groupings := []string{}
+	for _, g := range strings.Split(s, ",") {
+		if g = strings.TrimSpace(g); g != "" {
+			groupings = append(groupings, g)
+		}
+	}
+	return groupings
+}
+
+func groupingListStrings(ctx context.Context, l types.List, diags *diag.Diagnostics) []string {
+	groupings := []string{}
+	diags.Append(l.ElementsAs(ctx, &groupings, false)...)
+	return groupings
+}
+
+// costReportGroupingsFromPayload returns the groupings and grouping_list for
+// the groupings returned by the API. Order matters, as it sets how the report
+// nests groupings, so a reorder made in the console is reported as drift. The
+// prior groupings, from the plan or state, are only kept when they name the
+// same groupings in the same order, e.g. formatted with spaces.
+func costReportGroupingsFromPayload(ctx context.Context, payload *string, priorGroupings types.String, diags *diag.Diagnostics) (types.String, types.List) {
+	groupings := ptrStringOrEmpty(payload)
+	split := splitCostReportGroupings(groupings.ValueString())
+
+	if !priorGroupings.IsNull() && !priorGroupings.IsUnknown() && slices.Equal(splitCostReportGroupings(priorGroupings.ValueString()), split) {
+		groupings = priorGroupings
+	}
+
+	list, d := types.ListValueFrom(ctx, types.StringType, split)
+	diags.Append(d...)
+	return groupings, list
+}
+
+func (r CostReportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CostReportResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var groupings []string
+	switch {
+	case !data.GroupingList.IsNull():
+		if data.GroupingList.IsUnknown() {
+			return
+		}
+		for _, g := range data.GroupingList.Elements() {
+			if g.IsUnknown() {
+				return
+			}
+		}
+		groupings = groupingListStrings(ctx, data.GroupingList, &resp.Diagnostics)
+	case !data.Groupings.IsNull():
+		if data.Groupings.IsUnknown() {
+			return
+		}
+		groupings = splitCostReportGroupings(data.Groupings.ValueString())
+		for _, g := range groupings {
+			if !validCostReportGrouping(g) {
+				resp.Diagnostics.AddAttributeError(path.Root("groupings"), "Invalid Grouping",
+					fmt.Sprintf("%q is not a supported grouping. Use one of %s, or tag:<key>.", g, strings.Join(costReportGroupingDimensions, ", ")))
+			}
+		}
+	}
+
+	if data.ChartSettings.IsNull() || data.ChartSettings.IsUnknown() {
+		return
+	}
+	xAxis, ok := data.ChartSettings.Attributes()["x_axis_dimension"].(types.List)
+	if !ok || xAxis.IsNull() || xAxis.IsUnknown() {
+		return
+	}
+	for i, v := range xAxis.Elements() {
+		s, ok := v.(types.String)
+		if !ok || s.IsUnknown() || s.IsNull() {
+			continue
+		}
+		if d := s.ValueString(); d != "date" && !slices.Contains(groupings, d) {
+			resp.Diagnostics.AddAttributeError(path.Root("chart_settings").AtName("x_axis_dimension").AtListIndex(i), "Invalid X-Axis Dimension",
+				fmt.Sprintf("%q must be \"date\" or one of the Cost Report's groupings.", d))
+		}
+	}
+}
+
+// ModifyPlan keeps groupings and grouping_list in step, so either can be
+// configured and the other is planned from it, and plans filter from
+// filter_rules. The configured order is planned as is: reordering groupings
+// changes how the report nests them, so it must be sent to the API.
+func (r CostReportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	modifyPlanFilterRules(ctx, "costs", req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config, plan CostReportResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.GroupingList.IsNull() {
+		groupings := types.StringUnknown()
+		if !plan.GroupingList.IsUnknown() {
+			groupings = types.StringValue(strings.Join(groupingListStrings(ctx, plan.GroupingList, &resp.Diagnostics), ","))
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("groupings"), groupings)...)
+		return
+	}
+
+	groupingList := types.ListUnknown(types.StringType)
+	if !plan.Groupings.IsUnknown() {
+		var d diag.Diagnostics
+		groupingList, d = types.ListValueFrom(ctx, types.StringType, splitCostReportGroupings(plan.Groupings.ValueString()))
+		resp.Diagnostics.Append(d...)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("grouping_list"), groupingList)...)
+}
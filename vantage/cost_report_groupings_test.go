@@ -0,0 +1,74 @@
+package vantage
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSplitCostReportGroupings(t *testing.T) {
+	got := splitCostReportGroupings(" provider, service,,tag:team ")
+	want := []string{"provider", "service", "tag:team"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if got := splitCostReportGroupings(""); len(got) != 0 {
+		t.Errorf("expected no groupings, got %v", got)
+	}
+}
+
+func TestValidCostReportGrouping(t *testing.T) {
+	for g, want := range map[string]bool{
+		"provider":        true,
+		"tag:team":        true,
+		"tag:cost center": true,
+		"sevice":          false,
+		"tag:":            false,
+		"tags:team":       false,
+	} {
+		if got := validCostReportGrouping(g); got != want {
+			t.Errorf("%q: expected %v, got %v", g, want, got)
+		}
+	}
+}
+
+func TestCostReportGroupingsFromPayload(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	payload := "service,provider"
+
+	// The prior groupings are kept when only their formatting differs.
+	groupings, list := costReportGroupingsFromPayload(ctx, &payload, types.StringValue("service, provider"), &diags)
+	want, _ := types.ListValueFrom(ctx, types.StringType, []string{"service", "provider"})
+	if groupings.ValueString() != "service, provider" || !list.Equal(want) {
+		t.Errorf("expected the prior groupings to be kept, got %v and %v", groupings, list)
+	}
+
+	// A reorder, e.g. in the console, is drift.
+	payload = "provider,service"
+	groupings, list = costReportGroupingsFromPayload(ctx, &payload, types.StringValue("service,provider"), &diags)
+	want, _ = types.ListValueFrom(ctx, types.StringType, []string{"provider", "service"})
+	if groupings.ValueString() != "provider,service" || !list.Equal(want) {
+		t.Errorf("expected the API's order, got %v and %v", groupings, list)
+	}
+
+	// Different groupings are taken from the API.
+	payload = "provider,region"
+	groupings, list = costReportGroupingsFromPayload(ctx, &payload, types.StringValue("service,provider"), &diags)
+	want, _ = types.ListValueFrom(ctx, types.StringType, []string{"provider", "region"})
+	if groupings.ValueString() != "provider,region" || !list.Equal(want) {
+		t.Errorf("expected the API's groupings, got %v and %v", groupings, list)
+	}
+
+	// Imports have no prior values.
+	groupings, list = costReportGroupingsFromPayload(ctx, nil, types.StringNull(), &diags)
+	if groupings.ValueString() != "" || len(list.Elements()) != 0 || list.IsNull() {
+		t.Errorf("expected empty groupings, got %v and %v", groupings, list)
+	}
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+}
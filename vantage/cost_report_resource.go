@@ -53,9 +53,11 @@ type CostReportResourceModel struct {
 	Title                   types.String `tfsdk:"title"`
 	FolderToken             types.String `tfsdk:"folder_token"`
 	Filter                  types.String `tfsdk:"filter"`
+	FilterRules             types.Object `tfsdk:"filter_rules"`
 	SavedFilterTokens       types.List   `tfsdk:"saved_filter_tokens"`
 	WorkspaceToken          types.String `tfsdk:"workspace_token"`
 	Groupings               types.String `tfsdk:"groupings"`
+	GroupingList            types.List   `tfsdk:"grouping_list"`
 	StartDate               types.String `tfsdk:"start_date"`
 	EndDate                 types.String `tfsdk:"end_date"`
 	PreviousPeriodStartDate types.String `tfsdk:"previous_period_start_date"`
@@ -135,6 +137,7 @@ func (r CostReportResource) Schema(ctx context.Context, req resource.SchemaReque
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"filter_rules": filterRulesAttribute("costs"),
 			"groupings": schema.StringAttribute{
 				MarkdownDescription: "Grouping aggregations applied to the filtered data.",
 				Optional:            true,
@@ -142,6 +145,13 @@ func (r CostReportResource) Schema(ctx context.Context, req resource.SchemaReque
 				// https://discuss.hashicorp.com/t/framework-migration-test-produces-non-empty-plan/54523/8
 				Default: stringdefault.StaticString(""),
 			},
+			"grouping_list": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Grouping aggregations applied to the filtered data, as a list. Each grouping is one of `account_id`, `billing_account_id`, `charge_type`, `cost_category`, `cost_subcategory`, `provider`, `region`, `resource_id`, `service`, `tagged` or `tag:<key>`. Conflicts with `groupings`, which is computed from it; when `groupings` is set instead, this is computed from it. The order sets how the report nests groupings and is sent as configured; a reorder made in the console is reported as drift.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          costReportGroupingListValidators(),
+			},
 			"start_date": schema.StringAttribute{
 				MarkdownDescription: "Start date to apply to the Cost Report.",
 				Optional:            true,
@@ -190,7 +200,7 @@ func (r CostReportResource) Schema(ctx context.Context, req resource.SchemaReque
 				Attributes: map[string]schema.Attribute{
 					"x_axis_dimension": schema.ListAttribute{
 						ElementType:         types.StringType,
-						MarkdownDescription: "The dimension used to group or label data along the x-axis (e.g., by date, region, or service). NOTE: Only one value is allowed at this time. Defaults to ['date'].",
+						MarkdownDescription: "The dimension used to group or label data along the x-axis (e.g., by date, region, or service). Must be 'date' or one of the Cost Report's groupings. NOTE: Only one value is allowed at this time. Defaults to ['date'].",
 						Optional:            true,
 						Computed:            true,
 					},
@@ -377,8 +387,8 @@ func (r CostReportResource) Create(ctx context.Context, req resource.CreateReque
 
 	data.Token = types.StringValue(out.Payload.Token)
 	data.Id = types.StringValue(out.Payload.Token)
-	data.Filter = types.StringPointerValue(out.Payload.Filter)
-	data.Groupings = ptrStringOrEmpty(out.Payload.Groupings)
+	data.Filter = equivalentFilter(data.Filter, types.StringPointerValue(out.Payload.Filter))
+	data.Groupings, data.GroupingList = costReportGroupingsFromPayload(ctx, out.Payload.Groupings, data.Groupings, &resp.Diagnostics)
 	data.StartDate = types.StringPointerValue(out.Payload.StartDate)
 	data.EndDate = types.StringPointerValue(out.Payload.EndDate)
 	data.PreviousPeriodStartDate = types.StringPointerValue(out.Payload.PreviousPeriodStartDate)
@@ -434,9 +444,9 @@ func (r CostReportResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	state.Token = types.StringValue(out.Payload.Token)
 	state.Id = types.StringValue(out.Payload.Token)
-	state.Filter = types.StringPointerValue(out.Payload.Filter)
+	state.Filter = equivalentFilter(state.Filter, types.StringPointerValue(out.Payload.Filter))
 	state.Title = types.StringValue(out.Payload.Title)
-	state.Groupings = ptrStringOrEmpty(out.Payload.Groupings)
+	state.Groupings, state.GroupingList = costReportGroupingsFromPayload(ctx, out.Payload.Groupings, state.Groupings, &resp.Diagnostics)
 	state.StartDate = types.StringPointerValue(out.Payload.StartDate)
 	state.EndDate = types.StringPointerValue(out.Payload.EndDate)
 	state.PreviousPeriodStartDate = types.StringPointerValue(out.Payload.PreviousPeriodStartDate)
@@ -565,8 +575,8 @@ func (r CostReportResource) Update(ctx context.Context, req resource.UpdateReque
 
 	data.Title = types.StringValue(out.Payload.Title)
 	data.FolderToken = types.StringPointerValue(out.Payload.FolderToken)
-	data.Filter = types.StringPointerValue(out.Payload.Filter)
-	data.Groupings = ptrStringOrEmpty(out.Payload.Groupings)
+	data.Filter = equivalentFilter(data.Filter, types.StringPointerValue(out.Payload.Filter))
+	data.Groupings, data.GroupingList = costReportGroupingsFromPayload(ctx, out.Payload.Groupings, data.Groupings, &resp.Diagnostics)
 	data.WorkspaceToken = types.StringValue(out.Payload.WorkspaceToken)
 	data.StartDate = types.StringPointerValue(out.Payload.StartDate)
 	data.EndDate = types.StringPointerValue(out.Payload.EndDate)
@@ -646,5 +656,9 @@ func (r *CostReportResource) ConfigValidators(ctx context.Context) []resource.Co
 			path.MatchRoot("folder_token"),
 			path.MatchRoot("workspace_token"),
 		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("groupings"),
+			path.MatchRoot("grouping_list"),
+		),
 	}
 }
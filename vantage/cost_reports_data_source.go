@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
 	costsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/costs"
 )
 
@@ -41,7 +42,13 @@ type costReportDataSourceModel struct {
 }
 
 type costReportsDataSourceModel struct {
-	CostReports []costReportDataSourceModel `tfsdk:"cost_reports"`
+	WorkspaceToken types.String                `tfsdk:"workspace_token"`
+	FolderToken    types.String                `tfsdk:"folder_token"`
+	TitleRegex     types.String                `tfsdk:"title_regex"`
+	CreatedByToken types.String                `tfsdk:"created_by_token"`
+	CreatedAfter   types.String                `tfsdk:"created_after"`
+	CreatedBefore  types.String                `tfsdk:"created_before"`
+	CostReports    []costReportDataSourceModel `tfsdk:"cost_reports"`
 }
 
 func (d *costReportsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -115,15 +122,34 @@ func (d *costReportsDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 			},
 		},
 	}
+	for name, attr := range listFilterAttributes("Cost Reports", "title_regex",
+		"workspace_token", "folder_token", "title_regex", "created_by_token", "created_after", "created_before") {
+		resp.Schema.Attributes[name] = attr
+	}
 }
 
 func (d *costReportsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state costReportsDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := parseListFilter(listFilterConfig{
+		WorkspaceToken: state.WorkspaceToken,
+		FolderToken:    state.FolderToken,
+		TitleRegex:     state.TitleRegex,
+		CreatedByToken: state.CreatedByToken,
+		CreatedAfter:   state.CreatedAfter,
+		CreatedBefore:  state.CreatedBefore,
+	}, "title_regex", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	params := costsv2.NewGetCostReportsParams()
-	out, err := d.client.V2.Costs.GetCostReports(params, d.client.Auth)
+	// The endpoint filters by folder; the other filters are applied below.
+	reports, err := fetchAllCostReports(d.client, state.FolderToken.ValueStringPointer())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Get Vantage Costs",
@@ -134,7 +160,16 @@ func (d *costReportsDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 	costReports := []costReportDataSourceModel{}
 
-	for _, r := range out.Payload.CostReports {
+	for _, r := range reports {
+		if !filter.Matches(listFilterItem{
+			WorkspaceTokens: []string{r.WorkspaceToken},
+			FolderToken:     r.FolderToken,
+			Titles:          []string{r.Title},
+			CreatedByToken:  r.CreatedByToken,
+			CreatedAt:       r.CreatedAt,
+		}) {
+			continue
+		}
 		savedFilterTokens, diag := types.ListValueFrom(ctx, types.StringType, r.SavedFilterTokens)
 		if diag.HasError() {
 			resp.Diagnostics.Append(diag...)
@@ -178,3 +213,19 @@ func (d *costReportsDataSource) Configure(ctx context.Context, req datasource.Co
 
 	d.client = req.ProviderData.(*Client)
 }
+
+// fetchAllCostReports pages through the Get All Cost Reports endpoint,
+// optionally limited to the reports in folderToken.
+func fetchAllCostReports(client *Client, folderToken *string) ([]*modelsv2.CostReport, error) {
+	return fetchAllPages(func(limit int32, page *int32) ([]*modelsv2.CostReport, *modelsv2.Links, error) {
+		params := costsv2.NewGetCostReportsParams()
+		params.SetLimit(&limit)
+		params.SetPage(page)
+		params.SetFolderToken(folderToken)
+		out, err := client.V2.Costs.GetCostReports(params, client.Auth)
+		if err != nil {
+			return nil, nil, err
+		}
+		return out.Payload.CostReports, out.Payload.Links, nil
+	})
+}
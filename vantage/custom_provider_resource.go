@@ -236,37 +236,6 @@ func (r *CustomProviderResource) Delete(ctx context.Context, req resource.Delete
 // applyWorkspaces calls the UpdateIntegration endpoint with the given workspace
 // tokens and returns the set value to store in state. It is used by both Create
 // (post-creation association) and Update (in-place workspace change).
-//
-// Note: the Vantage API requires workspace_tokens to be non-empty. Passing an
-// empty set is a no-op — the existing associations are preserved and the empty
-// set is returned as-is.
 func (r *CustomProviderResource) applyWorkspaces(ctx context.Context, integrationToken string, workspaces types.Set, diags *diag.Diagnostics) types.Set {
-	var tokens []string
-	diags.Append(workspaces.ElementsAs(ctx, &tokens, false)...)
-	if diags.HasError() {
-		return workspaces
-	}
-
-	// The API rejects an empty workspace_tokens array. Nothing to do.
-	if len(tokens) == 0 {
-		return workspaces
-	}
-
-	updateParams := integrationsv2.NewUpdateIntegrationParams()
-	updateParams.SetIntegrationToken(integrationToken)
-	updateParams.WithUpdateIntegration(&modelsv2.UpdateIntegration{
-		WorkspaceTokens: tokens,
-	})
-
-	out, err := r.client.V2.Integrations.UpdateIntegration(updateParams, r.client.Auth)
-	if err != nil {
-		handleError("Update Custom Provider Workspaces", diags, err)
-		return workspaces
-	}
-
-	// Return the workspace tokens as confirmed by the API response.
-	result, d := types.SetValueFrom(ctx, types.StringType, out.Payload.WorkspaceTokens)
-	diags.Append(d...)
-	return result
+	return applyIntegrationWorkspaces(ctx, r.client, "Update Custom Provider Workspaces", integrationToken, workspaces, diags)
 }
-
@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/vantage-sh/terraform-provider-vantage/vantage/datasource_dashboards"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
 	dashboardsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/dashboards"
 )
 
@@ -14,7 +16,11 @@ var (
 )
 
 type dashboardsDataSourceModel struct {
-	Dashboards []dashboardModel `tfsdk:"dashboards"`
+	WorkspaceToken types.String     `tfsdk:"workspace_token"`
+	TitleRegex     types.String     `tfsdk:"title_regex"`
+	CreatedAfter   types.String     `tfsdk:"created_after"`
+	CreatedBefore  types.String     `tfsdk:"created_before"`
+	Dashboards     []dashboardModel `tfsdk:"dashboards"`
 }
 
 type dashboardsDataSource struct {
@@ -26,7 +32,12 @@ func NewDashboardsDataSource() datasource.DataSource {
 }
 
 func (d *dashboardsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = datasource_dashboards.DashboardsDataSourceSchema(ctx)
+	s := datasource_dashboards.DashboardsDataSourceSchema(ctx)
+	for name, attr := range listFilterAttributes("Dashboards", "title_regex",
+		"workspace_token", "title_regex", "created_after", "created_before") {
+		s.Attributes[name] = attr
+	}
+	resp.Schema = s
 }
 
 func (d *dashboardsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -44,8 +55,31 @@ func (d *dashboardsDataSource) Metadata(_ context.Context, req datasource.Metada
 func (d *dashboardsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state dashboardsDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
-	params := dashboardsv2.NewGetDashboardsParams()
-	out, err := d.client.V2.Dashboards.GetDashboards(params, d.client.Auth)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := parseListFilter(listFilterConfig{
+		WorkspaceToken: state.WorkspaceToken,
+		TitleRegex:     state.TitleRegex,
+		CreatedAfter:   state.CreatedAfter,
+		CreatedBefore:  state.CreatedBefore,
+	}, "title_regex", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The endpoint has no filter parameters, so filters are applied here.
+	dashboards, err := fetchAllPages(func(limit int32, page *int32) ([]*modelsv2.Dashboard, *modelsv2.Links, error) {
+		params := dashboardsv2.NewGetDashboardsParams()
+		params.SetLimit(&limit)
+		params.SetPage(page)
+		out, err := d.client.V2.Dashboards.GetDashboards(params, d.client.Auth)
+		if err != nil {
+			return nil, nil, err
+		}
+		return out.Payload.Dashboards, out.Payload.Links, nil
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Get Vantage Dashboards",
@@ -54,7 +88,14 @@ func (d *dashboardsDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	for _, dashboard := range out.Payload.Dashboards {
+	for _, dashboard := range dashboards {
+		if !filter.Matches(listFilterItem{
+			WorkspaceTokens: []string{dashboard.WorkspaceToken},
+			Titles:          []string{dashboard.Title},
+			CreatedAt:       dashboard.CreatedAt,
+		}) {
+			continue
+		}
 		d := dashboardModel{}
 		if diag := d.applyPayload(ctx, dashboard); diag.HasError() {
 			resp.Diagnostics.Append(diag...)
@@ -0,0 +1,43 @@
+package vantage
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	integrationsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/integrations"
+)
+
+func NewDatadogIntegrationResource() resource.Resource {
+	return newSaasIntegrationResource(saasIntegrationSpec{
+		TypeName:    "datadog_integration",
+		DisplayName: "Datadog",
+		Description: "Manages a Datadog integration, which imports Datadog usage costs and can source `datadog_metric_fields` of a `vantage_business_metric`.",
+		Fields: []saasIntegrationField{
+			{Name: "api_key", Description: "A Datadog API key.", Sensitive: true},
+			{Name: "application_key", Description: "A Datadog application key with the `usage_read` and `timeseries_query` scopes.", Sensitive: true},
+			{Name: "site", Description: "The Datadog site of the organization (e.g. `datadoghq.com`, `datadoghq.eu`). Defaults to `datadoghq.com`.", Optional: true},
+		},
+		Create: func(client *Client, values map[string]*string) (*modelsv2.Integration, error) {
+			payload := &modelsv2.CreateDatadogIntegration{
+				APIKey:         values["api_key"],
+				ApplicationKey: values["application_key"],
+			}
+			if values["site"] != nil {
+				payload.Site = *values["site"]
+			}
+
+			params := integrationsv2.NewCreateDatadogIntegrationParams()
+			params.WithCreateDatadogIntegration(payload)
+			out, err := client.V2.Integrations.CreateDatadogIntegration(params, client.Auth)
+			if err != nil {
+				return nil, err
+			}
+			return out.Payload, nil
+		},
+		BadRequest: func(err error) *modelsv2.Errors {
+			if e, ok := err.(*integrationsv2.CreateDatadogIntegrationBadRequest); ok {
+				return e.GetPayload()
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,310 @@
+package vantage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// filterRules is a structured filter, rendered to VQL by renderFilterRules.
+type filterRules struct {
+	Operator   string
+	Providers  []string
+	Services   []string
+	AccountIDs []string
+	Regions    []string
+	Tags       []filterRuleTag
+	Groups     []filterRules
+}
+
+type filterRuleTag struct {
+	Key      string
+	Values   []string
+	Operator string
+}
+
+type filterRulesModel struct {
+	Operator   types.String            `tfsdk:"operator"`
+	Providers  types.List              `tfsdk:"providers"`
+	Services   types.List              `tfsdk:"services"`
+	AccountIds types.List              `tfsdk:"account_ids"`
+	Regions    types.List              `tfsdk:"regions"`
+	Tags       []filterRuleTagModel    `tfsdk:"tags"`
+	Groups     []filterRulesGroupModel `tfsdk:"groups"`
+}
+
+type filterRulesGroupModel struct {
+	Operator   types.String         `tfsdk:"operator"`
+	Providers  types.List           `tfsdk:"providers"`
+	Services   types.List           `tfsdk:"services"`
+	AccountIds types.List           `tfsdk:"account_ids"`
+	Regions    types.List           `tfsdk:"regions"`
+	Tags       []filterRuleTagModel `tfsdk:"tags"`
+}
+
+type filterRuleTagModel struct {
+	Key      types.String `tfsdk:"key"`
+	Values   types.List   `tfsdk:"values"`
+	Operator types.String `tfsdk:"operator"`
+}
+
+// filterRulesAttribute returns the filter_rules attribute for a report whose
+// filter uses the VQL namespace. Only the "costs" namespace is supported: its
+// provider, service, account_id and region fields and tags.name/tags.value
+// syntax are the ones rendered here, and the other report namespaces filter
+// on different fields.
+func filterRulesAttribute(namespace string) schema.SingleNestedAttribute {
+	conditions := func() map[string]schema.Attribute {
+		list := func(field, what string) schema.ListAttribute {
+			return schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Match %s, rendered as `%s.%s`.", what, namespace, field),
+			}
+		}
+		return map[string]schema.Attribute{
+			"operator": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "How the conditions are combined: `and` or `or`. Defaults to `and`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("and", "or"),
+				},
+			},
+			"providers":   list("provider", "any of these providers"),
+			"services":    list("service", "any of these services"),
+			"account_ids": list("account_id", "any of these account ids"),
+			"regions":     list("region", "any of these regions"),
+			"tags": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Match tags. Each tag is a separate condition.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The tag key.",
+						},
+						"values": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							MarkdownDescription: "The tag values. When unset, anything tagged with `key` matches.",
+						},
+						"operator": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "`in` to match the values or `not_in` to exclude them. Defaults to `in`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("in", "not_in"),
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	attrs := conditions()
+	attrs["groups"] = schema.ListNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: "Nested groups of conditions, each combined with its own `operator` and then combined with the other conditions.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: conditions(),
+		},
+	}
+
+	return schema.SingleNestedAttribute{
+		Optional: true,
+		MarkdownDescription: "A structured filter, rendered to canonical VQL in `filter`. Values within a condition match any of them. " +
+			"A `filter` reformatted by the API or edited in the console to an equivalent expression is not reported as drift. Conflicts with `filter`.",
+		Attributes: attrs,
+		Validators: []validator.Object{
+			objectvalidator.ConflictsWith(path.MatchRoot("filter")),
+		},
+	}
+}
+
+// renderFilterRules renders rules to VQL in the namespace. Values are sorted
+// and conditions rendered in a fixed order, so equivalent rules render the
+// same filter.
+func renderFilterRules(rules filterRules, namespace string) (string, error) {
+	conditions, err := renderFilterRuleConditions(rules, namespace)
+	if err != nil {
+		return "", err
+	}
+	if len(conditions) == 0 {
+		return "", errors.New("filter_rules must set at least one condition")
+	}
+	return strings.Join(conditions, filterRulesJoin(rules.Operator)), nil
+}
+
+// renderFilterRuleConditions renders each condition of rules, to be joined
+// with the rules' operator.
+func renderFilterRuleConditions(rules filterRules, namespace string) ([]string, error) {
+	var conditions []string
+	for _, c := range []struct {
+		field  string
+		values []string
+	}{
+		{"provider", rules.Providers},
+		{"service", rules.Services},
+		{"account_id", rules.AccountIDs},
+		{"region", rules.Regions},
+	} {
+		if len(c.values) > 0 {
+			conditions = append(conditions, vqlMatch(namespace+"."+c.field, "in", c.values))
+		}
+	}
+
+	for _, tag := range rules.Tags {
+		if tag.Key == "" {
+			return nil, errors.New("every tag needs a key")
+		}
+		name := vqlMatch("tags.name", "in", []string{tag.Key})
+		if len(tag.Values) == 0 {
+			conditions = append(conditions, name)
+			continue
+		}
+		conditions = append(conditions, fmt.Sprintf("(%s AND %s)", name, vqlMatch("tags.value", tag.Operator, tag.Values)))
+	}
+
+	for _, g := range rules.Groups {
+		group, err := renderFilterRuleConditions(g, namespace)
+		if err != nil {
+			return nil, err
+		}
+		switch len(group) {
+		case 0:
+			return nil, errors.New("every group must set at least one condition")
+		case 1:
+			conditions = append(conditions, group[0])
+		default:
+			conditions = append(conditions, "("+strings.Join(group, filterRulesJoin(g.Operator))+")")
+		}
+	}
+	return conditions, nil
+}
+
+func filterRulesJoin(operator string) string {
+	if operator == "or" {
+		return " OR "
+	}
+	return " AND "
+}
+
+// vqlMatch renders a match of field against values, as `=`/`!=` for a single
+// value and `IN`/`NOT IN` otherwise.
+func vqlMatch(field, operator string, values []string) string {
+	values = slices.Clone(values)
+	slices.Sort(values)
+	values = slices.Compact(values)
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		quoted = append(quoted, "'"+strings.ReplaceAll(v, "'", "\\'")+"'")
+	}
+
+	negate := operator == "not_in"
+	if len(quoted) == 1 {
+		if negate {
+			return fmt.Sprintf("%s != %s", field, quoted[0])
+		}
+		return fmt.Sprintf("%s = %s", field, quoted[0])
+	}
+	if negate {
+		return fmt.Sprintf("%s NOT IN (%s)", field, strings.Join(quoted, ", "))
+	}
+	return fmt.Sprintf("%s IN (%s)", field, strings.Join(quoted, ", "))
+}
+
+func (m filterRulesModel) rules(ctx context.Context, diags *diag.Diagnostics) filterRules {
+	rules := filterRules{
+		Operator:   m.Operator.ValueString(),
+		Providers:  filterRuleStrings(ctx, m.Providers, diags),
+		Services:   filterRuleStrings(ctx, m.Services, diags),
+		AccountIDs: filterRuleStrings(ctx, m.AccountIds, diags),
+		Regions:    filterRuleStrings(ctx, m.Regions, diags),
+		Tags:       filterRuleTags(ctx, m.Tags, diags),
+	}
+	for _, g := range m.Groups {
+		rules.Groups = append(rules.Groups, filterRules{
+			Operator:   g.Operator.ValueString(),
+			Providers:  filterRuleStrings(ctx, g.Providers, diags),
+			Services:   filterRuleStrings(ctx, g.Services, diags),
+			AccountIDs: filterRuleStrings(ctx, g.AccountIds, diags),
+			Regions:    filterRuleStrings(ctx, g.Regions, diags),
+			Tags:       filterRuleTags(ctx, g.Tags, diags),
+		})
+	}
+	return rules
+}
+
+func filterRuleStrings(ctx context.Context, l types.List, diags *diag.Diagnostics) []string {
+	if l.IsNull() {
+		return nil
+	}
+	var values []string
+	diags.Append(l.ElementsAs(ctx, &values, false)...)
+	return values
+}
+
+func filterRuleTags(ctx context.Context, tags []filterRuleTagModel, diags *diag.Diagnostics) []filterRuleTag {
+	out := make([]filterRuleTag, 0, len(tags))
+	for _, t := range tags {
+		out = append(out, filterRuleTag{
+			Key:      t.Key.ValueString(),
+			Values:   filterRuleStrings(ctx, t.Values, diags),
+			Operator: t.Operator.ValueString(),
+		})
+	}
+	return out
+}
+
+// modifyPlanFilterRules plans filter from a configured filter_rules. The
+// filter in state is kept when it is equivalent to the rendered rules.
+func modifyPlanFilterRules(ctx context.Context, namespace string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var rulesObj types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("filter_rules"), &rulesObj)...)
+	if resp.Diagnostics.HasError() || rulesObj.IsNull() {
+		return
+	}
+	if v, err := rulesObj.ToTerraformValue(ctx); err != nil || !v.IsFullyKnown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("filter"), types.StringUnknown())...)
+		return
+	}
+
+	var m filterRulesModel
+	resp.Diagnostics.Append(rulesObj.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rules := m.rules(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	vql, err := renderFilterRules(rules, namespace)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("filter_rules"), "Invalid Filter Rules", err.Error())
+		return
+	}
+
+	filter := types.StringValue(vql)
+	if !req.State.Raw.IsNull() {
+		var stateFilter types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("filter"), &stateFilter)...)
+		filter = equivalentFilter(stateFilter, filter)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("filter"), filter)...)
+}
@@ -0,0 +1,111 @@
+package vantage
+
+import (
+	"testing"
+)
+
+func TestRenderFilterRules(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		rules     filterRules
+		namespace string
+		want      string
+	}{
+		{
+			name:      "single values",
+			rules:     filterRules{Providers: []string{"aws"}, Regions: []string{"us-east-1"}},
+			namespace: "costs",
+			want:      "costs.provider = 'aws' AND costs.region = 'us-east-1'",
+		},
+		{
+			name:      "sorted lists",
+			rules:     filterRules{Operator: "or", Services: []string{"AmazonS3", "AmazonEC2", "AmazonS3"}, AccountIDs: []string{"2", "1"}},
+			namespace: "costs",
+			want:      "costs.service IN ('AmazonEC2', 'AmazonS3') OR costs.account_id IN ('1', '2')",
+		},
+		{
+			name: "tags",
+			rules: filterRules{Tags: []filterRuleTag{
+				{Key: "team", Values: []string{"web", "api"}},
+				{Key: "env", Values: []string{"dev"}, Operator: "not_in"},
+				{Key: "owner"},
+			}},
+			namespace: "costs",
+			want:      "(tags.name = 'team' AND tags.value IN ('api', 'web')) AND (tags.name = 'env' AND tags.value != 'dev') AND tags.name = 'owner'",
+		},
+		{
+			name: "groups",
+			rules: filterRules{
+				Providers: []string{"aws"},
+				Groups: []filterRules{
+					{Operator: "or", Regions: []string{"a"}, Tags: []filterRuleTag{{Key: "team", Values: []string{"x"}}}},
+					{Services: []string{"s"}},
+				},
+			},
+			namespace: "costs",
+			want:      "costs.provider = 'aws' AND (costs.region = 'a' OR (tags.name = 'team' AND tags.value = 'x')) AND costs.service = 's'",
+		},
+		{
+			name:      "quotes",
+			rules:     filterRules{Tags: []filterRuleTag{{Key: "team", Values: []string{"o'brien"}}}},
+			namespace: "costs",
+			want:      "(tags.name = 'team' AND tags.value = 'o\\'brien')",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := renderFilterRules(tc.rules, tc.namespace)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+			if _, err := canonicalVQL(got); err != nil {
+				t.Errorf("rendered filter doesn't parse: %v", err)
+			}
+		})
+	}
+}
+
+func TestRenderFilterRulesEquivalentToHandWritten(t *testing.T) {
+	got, err := renderFilterRules(filterRules{
+		Providers: []string{"aws"},
+		Services:  []string{"AmazonS3", "AmazonEC2"},
+	}, "costs")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The same filter as the console might save it.
+	if edited := "(costs.service IN ('AmazonEC2','AmazonS3') AND costs.provider IN ('aws'))"; !equivalentVQL(got, edited) {
+		t.Errorf("expected %q to be equivalent to %q", got, edited)
+	}
+}
+
+func TestRenderFilterRulesErrors(t *testing.T) {
+	for name, rules := range map[string]filterRules{
+		"empty":       {},
+		"empty group": {Providers: []string{"aws"}, Groups: []filterRules{{}}},
+		"tag key":     {Tags: []filterRuleTag{{Values: []string{"x"}}}},
+	} {
+		if _, err := renderFilterRules(rules, "costs"); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestRenderFilterRulesSwappedTagValues(t *testing.T) {
+	render := func(env, team string) string {
+		got, err := renderFilterRules(filterRules{Tags: []filterRuleTag{
+			{Key: "env", Values: []string{env}},
+			{Key: "team", Values: []string{team}},
+		}}, "costs")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return got
+	}
+	// Swapping values between tags changes the filter, so it must be planned.
+	if a, b := render("prod", "a"), render("a", "prod"); equivalentVQL(a, b) {
+		t.Errorf("expected %q and %q not to be equivalent", a, b)
+	}
+}
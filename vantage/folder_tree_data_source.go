@@ -0,0 +1,355 @@
+package vantage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+)
+
+var (
+	_ datasource.DataSource              = (*folderTreeDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*folderTreeDataSource)(nil)
+)
+
+func NewFolderTreeDataSource() datasource.DataSource {
+	return &folderTreeDataSource{}
+}
+
+type folderTreeDataSource struct {
+	client *Client
+}
+
+type folderTreeDataSourceModel struct {
+	WorkspaceToken  types.String            `tfsdk:"workspace_token"`
+	RootFolderToken types.String            `tfsdk:"root_folder_token"`
+	Path            types.String            `tfsdk:"path"`
+	Token           types.String            `tfsdk:"token"`
+	Folders         []folderTreeFolderModel `tfsdk:"folders"`
+	Tree            types.String            `tfsdk:"tree"`
+}
+
+type folderTreeFolderModel struct {
+	Token             types.String `tfsdk:"token"`
+	Title             types.String `tfsdk:"title"`
+	Path              types.String `tfsdk:"path"`
+	Depth             types.Int64  `tfsdk:"depth"`
+	ParentFolderToken types.String `tfsdk:"parent_folder_token"`
+	WorkspaceToken    types.String `tfsdk:"workspace_token"`
+	ChildFolderTokens types.List   `tfsdk:"child_folder_tokens"`
+	CostReportTokens  types.List   `tfsdk:"cost_report_tokens"`
+	SavedFilterTokens types.List   `tfsdk:"saved_filter_tokens"`
+}
+
+// folderTreeNode is a folder with its children resolved. It is also the JSON
+// shape of the tree attribute.
+type folderTreeNode struct {
+	Token             string            `json:"token"`
+	Title             string            `json:"title"`
+	Path              string            `json:"path"`
+	Depth             int64             `json:"depth"`
+	ParentFolderToken *string           `json:"parent_folder_token"`
+	WorkspaceToken    string            `json:"workspace_token"`
+	CostReportTokens  []string          `json:"cost_report_tokens"`
+	SavedFilterTokens []string          `json:"saved_filter_tokens"`
+	Children          []*folderTreeNode `json:"children"`
+}
+
+func (d *folderTreeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*Client)
+}
+
+func (d *folderTreeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder_tree"
+}
+
+func (d *folderTreeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns the folder hierarchy of a workspace, or of the subtree under a folder, with the Cost Reports and Saved Filters in each folder. Folder paths are the slash-separated titles from the top level of the workspace, e.g. `Engineering/Platform/Prod`.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_token": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only include folders in this workspace. Defaults to all workspaces.",
+			},
+			"root_folder_token": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only include this folder and its descendants. Paths are still relative to the top level of the workspace.",
+			},
+			"path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Look up a folder by its path, e.g. `Engineering/Platform/Prod`, and return its token in `token`. Fails if no folder or more than one folder has the path.",
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The token of the folder at `path`. Null when `path` is not set.",
+			},
+			"folders": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Every folder in the tree, depth-first with siblings ordered by title.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"token": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The token of the folder.",
+						},
+						"title": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The title of the folder.",
+						},
+						"path": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The slash-separated titles of the folder and its ancestors.",
+						},
+						"depth": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of ancestors of the folder. Top-level folders have a depth of 0.",
+						},
+						"parent_folder_token": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The token of the parent folder. Null for top-level folders.",
+						},
+						"workspace_token": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The token of the workspace the folder is in.",
+						},
+						"child_folder_tokens": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The tokens of the folder's direct subfolders.",
+						},
+						"cost_report_tokens": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The tokens of the Cost Reports directly in the folder.",
+						},
+						"saved_filter_tokens": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The tokens of the Saved Filters in the folder.",
+						},
+					},
+				},
+			},
+			"tree": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The nested tree encoded as JSON: a list of top-level folders, each with the attributes of `folders` and a `children` list. Decode it with `jsondecode`.",
+			},
+		},
+	}
+}
+
+func (d *folderTreeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state folderTreeDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folders, err := fetchAllFolders(d.client)
+	if err != nil {
+		handleError("Read Folder Tree", &resp.Diagnostics, err)
+		return
+	}
+	reports, err := fetchAllCostReports(d.client, nil)
+	if err != nil {
+		handleError("Read Folder Tree", &resp.Diagnostics, err)
+		return
+	}
+
+	workspaceToken := state.WorkspaceToken.ValueString()
+	if workspaceToken != "" {
+		var inWorkspace []*modelsv2.Folder
+		for _, f := range folders {
+			if f.WorkspaceToken == workspaceToken {
+				inWorkspace = append(inWorkspace, f)
+			}
+		}
+		folders = inWorkspace
+	}
+
+	roots := buildFolderTree(folders, reports)
+
+	if p := state.Path.ValueString(); p != "" {
+		matches := findFolderTreePath(roots, p)
+		switch len(matches) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(path.Root("path"), "Folder Not Found", fmt.Sprintf("No folder with path %q was found.", p))
+			return
+		case 1:
+			state.Token = types.StringValue(matches[0].Token)
+		default:
+			tokens := make([]string, 0, len(matches))
+			for _, m := range matches {
+				tokens = append(tokens, m.Token)
+			}
+			resp.Diagnostics.AddAttributeError(path.Root("path"), "Multiple Folders Found",
+				fmt.Sprintf("%d folders with path %q were found (%s). Set workspace_token to narrow the lookup.", len(matches), p, strings.Join(tokens, ", ")))
+			return
+		}
+	} else {
+		state.Token = types.StringNull()
+	}
+
+	if rootToken := state.RootFolderToken.ValueString(); rootToken != "" {
+		root := findFolderTreeNode(roots, rootToken)
+		if root == nil {
+			resp.Diagnostics.AddAttributeError(path.Root("root_folder_token"), "Folder Not Found", fmt.Sprintf("No folder with token %q was found.", rootToken))
+			return
+		}
+		roots = []*folderTreeNode{root}
+	}
+
+	state.Folders = []folderTreeFolderModel{}
+	var walk func(nodes []*folderTreeNode)
+	walk = func(nodes []*folderTreeNode) {
+		for _, n := range nodes {
+			childTokens := make([]string, 0, len(n.Children))
+			for _, c := range n.Children {
+				childTokens = append(childTokens, c.Token)
+			}
+			childList, diags := types.ListValueFrom(ctx, types.StringType, childTokens)
+			resp.Diagnostics.Append(diags...)
+			reportList, diags := types.ListValueFrom(ctx, types.StringType, n.CostReportTokens)
+			resp.Diagnostics.Append(diags...)
+			filterList, diags := types.ListValueFrom(ctx, types.StringType, n.SavedFilterTokens)
+			resp.Diagnostics.Append(diags...)
+
+			state.Folders = append(state.Folders, folderTreeFolderModel{
+				Token:             types.StringValue(n.Token),
+				Title:             types.StringValue(n.Title),
+				Path:              types.StringValue(n.Path),
+				Depth:             types.Int64Value(n.Depth),
+				ParentFolderToken: types.StringPointerValue(n.ParentFolderToken),
+				WorkspaceToken:    types.StringValue(n.WorkspaceToken),
+				ChildFolderTokens: childList,
+				CostReportTokens:  reportList,
+				SavedFilterTokens: filterList,
+			})
+			walk(n.Children)
+		}
+	}
+	walk(roots)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	encoded, err := json.Marshal(roots)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Encode Folder Tree", err.Error())
+		return
+	}
+	state.Tree = types.StringValue(string(encoded))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// buildFolderTree links folders to their parents and attaches the cost
+// reports in each folder. Folders whose parent is not in folders are treated
+// as top-level. Siblings are sorted by title, then token, so the output is
+// stable across reads.
+func buildFolderTree(folders []*modelsv2.Folder, reports []*modelsv2.CostReport) []*folderTreeNode {
+	nodes := make(map[string]*folderTreeNode, len(folders))
+	for _, f := range folders {
+		title := ""
+		if f.Title != nil {
+			title = *f.Title
+		}
+		savedFilterTokens := append([]string{}, f.SavedFilterTokens...)
+		sort.Strings(savedFilterTokens)
+		nodes[f.Token] = &folderTreeNode{
+			Token:             f.Token,
+			Title:             title,
+			ParentFolderToken: f.ParentFolderToken,
+			WorkspaceToken:    f.WorkspaceToken,
+			CostReportTokens:  []string{},
+			SavedFilterTokens: savedFilterTokens,
+			Children:          []*folderTreeNode{},
+		}
+	}
+
+	for _, r := range reports {
+		if r.FolderToken == nil {
+			continue
+		}
+		if n, ok := nodes[*r.FolderToken]; ok {
+			n.CostReportTokens = append(n.CostReportTokens, r.Token)
+		}
+	}
+
+	roots := []*folderTreeNode{}
+	for _, f := range folders {
+		n := nodes[f.Token]
+		sort.Strings(n.CostReportTokens)
+		if f.ParentFolderToken != nil {
+			if parent, ok := nodes[*f.ParentFolderToken]; ok && parent != n {
+				parent.Children = append(parent.Children, n)
+				continue
+			}
+		}
+		roots = append(roots, n)
+	}
+
+	var setPaths func(nodes []*folderTreeNode, prefix string, depth int64)
+	setPaths = func(nodes []*folderTreeNode, prefix string, depth int64) {
+		sortFolderTreeNodes(nodes)
+		for _, n := range nodes {
+			n.Path = prefix + n.Title
+			n.Depth = depth
+			setPaths(n.Children, n.Path+"/", depth+1)
+		}
+	}
+	setPaths(roots, "", 0)
+
+	return roots
+}
+
+func sortFolderTreeNodes(nodes []*folderTreeNode) {
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].Title != nodes[j].Title {
+			return nodes[i].Title < nodes[j].Title
+		}
+		return nodes[i].Token < nodes[j].Token
+	})
+}
+
+// findFolderTreePath returns every folder whose path is p. Leading and
+// trailing slashes in p are ignored.
+func findFolderTreePath(roots []*folderTreeNode, p string) []*folderTreeNode {
+	p = strings.Trim(p, "/")
+	var matches []*folderTreeNode
+	var walk func(nodes []*folderTreeNode)
+	walk = func(nodes []*folderTreeNode) {
+		for _, n := range nodes {
+			if n.Path == p {
+				matches = append(matches, n)
+			}
+			if strings.HasPrefix(p, n.Path+"/") {
+				walk(n.Children)
+			}
+		}
+	}
+	walk(roots)
+	return matches
+}
+
+func findFolderTreeNode(roots []*folderTreeNode, token string) *folderTreeNode {
+	for _, n := range roots {
+		if n.Token == token {
+			return n
+		}
+		if found := findFolderTreeNode(n.Children, token); found != nil {
+			return found
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package vantage
+
+import (
+	"testing"
+
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+)
+
+func testFolderTree() []*folderTreeNode {
+	eng := "fldr_eng"
+	platform := "fldr_platform"
+	folders := []*modelsv2.Folder{
+		mockFolder("fldr_prod", "Prod", "wrkspc_1", &platform),
+		mockFolder("fldr_platform", "Platform", "wrkspc_1", &eng),
+		mockFolder("fldr_eng", "Engineering", "wrkspc_1", nil),
+		mockFolder("fldr_data", "Data", "wrkspc_1", &eng),
+		mockFolder("fldr_finance", "Finance", "wrkspc_1", nil),
+	}
+	folders[0].SavedFilterTokens = []string{"svd_fltr_2", "svd_fltr_1"}
+	reports := []*modelsv2.CostReport{
+		{Token: "rprt_b", FolderToken: &folders[0].Token},
+		{Token: "rprt_a", FolderToken: &folders[0].Token},
+		{Token: "rprt_root"},
+	}
+	return buildFolderTree(folders, reports)
+}
+
+func TestBuildFolderTree(t *testing.T) {
+	roots := testFolderTree()
+
+	if len(roots) != 2 || roots[0].Title != "Engineering" || roots[1].Title != "Finance" {
+		t.Fatalf("expected top-level folders [Engineering Finance], got %+v", roots)
+	}
+	eng := roots[0]
+	if len(eng.Children) != 2 || eng.Children[0].Title != "Data" || eng.Children[1].Title != "Platform" {
+		t.Fatalf("expected Engineering children [Data Platform] sorted by title, got %+v", eng.Children)
+	}
+
+	prod := eng.Children[1].Children[0]
+	if prod.Path != "Engineering/Platform/Prod" {
+		t.Errorf("expected path Engineering/Platform/Prod, got %q", prod.Path)
+	}
+	if prod.Depth != 2 {
+		t.Errorf("expected depth 2, got %d", prod.Depth)
+	}
+	if got := prod.CostReportTokens; len(got) != 2 || got[0] != "rprt_a" || got[1] != "rprt_b" {
+		t.Errorf("expected sorted cost reports [rprt_a rprt_b], got %v", got)
+	}
+	if got := prod.SavedFilterTokens; len(got) != 2 || got[0] != "svd_fltr_1" {
+		t.Errorf("expected sorted saved filters, got %v", got)
+	}
+}
+
+func TestBuildFolderTree_orphanIsTopLevel(t *testing.T) {
+	missing := "fldr_other_workspace"
+	roots := buildFolderTree([]*modelsv2.Folder{
+		mockFolder("fldr_orphan", "Orphan", "wrkspc_1", &missing),
+	}, nil)
+
+	if len(roots) != 1 || roots[0].Path != "Orphan" || roots[0].Depth != 0 {
+		t.Errorf("expected orphan folder as top-level, got %+v", roots)
+	}
+}
+
+func TestFindFolderTreePath(t *testing.T) {
+	roots := testFolderTree()
+
+	for _, p := range []string{"Engineering/Platform/Prod", "/Engineering/Platform/Prod/"} {
+		matches := findFolderTreePath(roots, p)
+		if len(matches) != 1 || matches[0].Token != "fldr_prod" {
+			t.Errorf("findFolderTreePath(%q) = %+v, want fldr_prod", p, matches)
+		}
+	}
+	if matches := findFolderTreePath(roots, "Engineering/Prod"); len(matches) != 0 {
+		t.Errorf("expected no match for a skipped level, got %+v", matches)
+	}
+	if node := findFolderTreeNode(roots, "fldr_platform"); node == nil || node.Path != "Engineering/Platform" {
+		t.Errorf("findFolderTreeNode(fldr_platform) = %+v", node)
+	}
+}
@@ -0,0 +1,236 @@
+package vantage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vantage-sh/terraform-provider-vantage/vantage/planmodifiers"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	integrationsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/integrations"
+)
+
+var (
+	_ resource.Resource                = (*GcpIntegrationResource)(nil)
+	_ resource.ResourceWithConfigure   = (*GcpIntegrationResource)(nil)
+	_ resource.ResourceWithImportState = (*GcpIntegrationResource)(nil)
+)
+
+type GcpIntegrationResource struct{ client *Client }
+
+func NewGcpIntegrationResource() resource.Resource { return &GcpIntegrationResource{} }
+
+type GcpIntegrationResourceModel struct {
+	BillingAccountId types.String `tfsdk:"billing_account_id"`
+	ProjectId        types.String `tfsdk:"project_id"`
+	DatasetName      types.String `tfsdk:"dataset_name"`
+	Workspaces       types.Set    `tfsdk:"workspaces"`
+	Token            types.String `tfsdk:"token"`
+	Id               types.String `tfsdk:"id"`
+	Status           types.String `tfsdk:"status"`
+}
+
+func (r *GcpIntegrationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *GcpIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gcp_integration"
+}
+
+func (r *GcpIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"billing_account_id": schema.StringAttribute{
+				Required: true,
+				// Remove when API supports updating.
+				PlanModifiers:       []planmodifier.String{planmodifiers.ImmutableAfterCreate("billing_account_id")},
+				MarkdownDescription: "The GCP billing account id whose billing export Vantage reads (e.g. `012345-6789AB-CDEF01`). Cannot be changed after creation.",
+			},
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{planmodifiers.ImmutableAfterCreate("project_id")},
+				MarkdownDescription: "The id of the GCP project that contains the BigQuery billing export dataset. Cannot be changed after creation.",
+			},
+			"dataset_name": schema.StringAttribute{
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{planmodifiers.ImmutableAfterCreate("dataset_name")},
+				MarkdownDescription: "The name of the BigQuery dataset the detailed billing export is written to. Cannot be changed after creation.",
+			},
+			"workspaces": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers:       []planmodifier.Set{planmodifiers.UseStateWhenEmpty()},
+				MarkdownDescription: "Workspace tokens to associate with the integration. Can be updated in-place. Note: the Vantage API requires at least one token — workspace associations cannot be fully removed once set.",
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "Unique token of the GCP integration.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "Same as token.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "The status of the integration. On create, the provider waits for the integration to connect or error before returning. This is system-managed and cannot be configured.",
+			},
+		},
+		MarkdownDescription: "Manages a GCP integration that imports costs from a BigQuery billing export.",
+	}
+}
+
+func (r *GcpIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("token"), req, resp)
+}
+
+func (r *GcpIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GcpIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := integrationsv2.NewCreateGCPIntegrationParams()
+	params.WithCreateGCPIntegration(&modelsv2.CreateGCPIntegration{
+		BillingAccountID: data.BillingAccountId.ValueStringPointer(),
+		ProjectID:        data.ProjectId.ValueStringPointer(),
+		DatasetName:      data.DatasetName.ValueStringPointer(),
+	})
+
+	out, err := r.client.V2.Integrations.CreateGCPIntegration(params, r.client.Auth)
+	if err != nil {
+		if e, ok := err.(*integrationsv2.CreateGCPIntegrationBadRequest); ok {
+			handleBadRequest("Create GCP Integration", &resp.Diagnostics, e.GetPayload())
+			return
+		}
+		handleError("Create GCP Integration", &resp.Diagnostics, err)
+		return
+	}
+
+	data.Token = types.StringValue(out.Payload.Token)
+	data.Id = types.StringValue(out.Payload.Token)
+	data.Status = types.StringValue(out.Payload.Status)
+
+	// Associate workspaces immediately after creation if specified;
+	// otherwise resolve to an empty set so the value is always known after apply.
+	if !data.Workspaces.IsNull() && !data.Workspaces.IsUnknown() {
+		data.Workspaces = applyIntegrationWorkspaces(ctx, r.client, "Update GCP Integration Workspaces", out.Payload.Token, data.Workspaces, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			// The integration exists: persist it so that it is tainted
+			// rather than orphaned.
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	} else {
+		data.Workspaces, _ = types.SetValueFrom(ctx, types.StringType, []string{})
+	}
+
+	// Persist state even if the integration fails to connect so that it is
+	// tainted, rather than orphaned, and replaced on the next apply.
+	if integration := awaitIntegrationConnected(ctx, r.client, "Create GCP Integration", out.Payload.Token, &resp.Diagnostics); integration != nil {
+		data.Status = types.StringValue(integration.Status)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GcpIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state GcpIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := integrationsv2.NewGetIntegrationParams()
+	params.SetIntegrationToken(state.Token.ValueString())
+
+	out, err := r.client.V2.Integrations.GetIntegration(params, r.client.Auth)
+	if err != nil {
+		if _, ok := err.(*integrationsv2.GetIntegrationNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		handleError("Read GCP Integration", &resp.Diagnostics, err)
+		return
+	}
+
+	state.Token = types.StringValue(out.Payload.Token)
+	state.Id = types.StringValue(out.Payload.Token)
+	state.Status = types.StringValue(out.Payload.Status)
+
+	workspaces, diags := types.SetValueFrom(ctx, types.StringType, out.Payload.WorkspaceTokens)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Workspaces = workspaces
+
+	// project_id and dataset_name are not returned by the generic GET endpoint,
+	// so preserve whatever is already in state. On a fresh import the billing
+	// account id is seeded from AccountIdentifier.
+	if state.BillingAccountId.IsNull() || state.BillingAccountId.ValueString() == "" {
+		if out.Payload.AccountIdentifier != nil {
+			state.BillingAccountId = types.StringValue(*out.Payload.AccountIdentifier)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *GcpIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// The billing export settings are guarded by ImmutableAfterCreate plan
+	// modifiers, so only workspace changes reach the API.
+	var plan GcpIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state GcpIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Token = state.Token
+	plan.Id = state.Id
+	plan.Status = state.Status
+
+	plan.Workspaces = applyIntegrationWorkspaces(ctx, r.client, "Update GCP Integration Workspaces", state.Token.ValueString(), plan.Workspaces, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *GcpIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state GcpIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := integrationsv2.NewDeleteIntegrationParams()
+	params.SetIntegrationToken(state.Token.ValueString())
+
+	_, err := r.client.V2.Integrations.DeleteIntegration(params, r.client.Auth)
+	if err != nil {
+		if _, ok := err.(*integrationsv2.DeleteIntegrationNotFound); ok {
+			return
+		}
+		handleError("Delete GCP Integration", &resp.Diagnostics, err)
+	}
+}
@@ -0,0 +1,96 @@
+package vantage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	integrationsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/integrations"
+)
+
+// integrationStatusPollInterval is how often waitForIntegrationStatus checks
+// the integration. It is a variable so tests can shorten it.
+var integrationStatusPollInterval = 10 * time.Second
+
+// integrationStatusTimeout bounds how long Create waits for a new cloud
+// integration to validate its credentials.
+const integrationStatusTimeout = 10 * time.Minute
+
+var errIntegrationStatusTimeout = errors.New("timed out waiting for the integration to connect")
+
+// integrationStatusSettled reports whether the integration has finished
+// validating its credentials, successfully or not.
+func integrationStatusSettled(status string) bool {
+	switch status {
+	case "connected", "importing", "imported", "error", "disconnected":
+		return true
+	}
+	return false
+}
+
+// integrationStatusFailed reports whether a settled status means the
+// credentials were rejected.
+func integrationStatusFailed(status string) bool {
+	return status == "error" || status == "disconnected"
+}
+
+// waitForIntegrationStatus polls GetIntegration until the integration's status
+// settles (see integrationStatusSettled) and returns the last integration
+// read. If the status has not settled within timeout, the last integration is
+// returned together with errIntegrationStatusTimeout.
+func waitForIntegrationStatus(ctx context.Context, client *Client, integrationToken string, timeout time.Duration) (*modelsv2.Integration, error) {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		params := integrationsv2.NewGetIntegrationParams()
+		params.SetIntegrationToken(integrationToken)
+
+		out, err := client.V2.Integrations.GetIntegration(params, client.Auth)
+		if err != nil {
+			return nil, err
+		}
+
+		if integrationStatusSettled(out.Payload.Status) {
+			return out.Payload, nil
+		}
+		if time.Now().Add(integrationStatusPollInterval).After(deadline) {
+			return out.Payload, errIntegrationStatusTimeout
+		}
+
+		select {
+		case <-ctx.Done():
+			return out.Payload, ctx.Err()
+		case <-time.After(integrationStatusPollInterval):
+		}
+	}
+}
+
+// awaitIntegrationConnected waits for a newly created integration to settle
+// and records the outcome in diags: an error if the credentials were rejected,
+// a warning if the integration is still pending after integrationStatusTimeout.
+// It returns the last integration read, or nil if none could be read.
+func awaitIntegrationConnected(ctx context.Context, client *Client, action, integrationToken string, diags *diag.Diagnostics) *modelsv2.Integration {
+	integration, err := waitForIntegrationStatus(ctx, client, integrationToken, integrationStatusTimeout)
+	switch {
+	case errors.Is(err, errIntegrationStatusTimeout):
+		diags.AddWarning(
+			"Integration Not Yet Connected",
+			fmt.Sprintf("Integration %s was created but is still %q after %s. Its status will be refreshed on the next plan.", integrationToken, integration.Status, integrationStatusTimeout),
+		)
+		return integration
+	case err != nil:
+		handleError(action, diags, err)
+		return integration
+	}
+
+	if integrationStatusFailed(integration.Status) {
+		diags.AddError(
+			"Integration Failed to Connect",
+			fmt.Sprintf("Integration %s was created but its status is %q. Check the credentials and permissions granted to Vantage; the resource is marked as tainted and will be replaced on the next apply.", integrationToken, integration.Status),
+		)
+	}
+	return integration
+}
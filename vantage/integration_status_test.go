@@ -0,0 +1,101 @@
+package vantage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// ---------------------------------------------------------------------------
+// waitForIntegrationStatus unit tests — driven by a mock HTTP server
+// ---------------------------------------------------------------------------
+
+// newMockIntegrationStatusServer serves GET /v2/integrations/accss_crdntl_1,
+// returning the given statuses in order and repeating the last one.
+func newMockIntegrationStatusServer(t *testing.T, statuses ...string) (*httptest.Server, *int) {
+	t.Helper()
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/integrations/accss_crdntl_1" {
+			http.NotFound(w, r)
+			return
+		}
+		status := statuses[len(statuses)-1]
+		if calls < len(statuses) {
+			status = statuses[calls]
+		}
+		calls++
+		integration := mockIntegration("accss_crdntl_1", "123456789012")
+		integration.Status = status
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(integration)
+	}))
+	return srv, &calls
+}
+
+func shortenIntegrationStatusPollInterval(t *testing.T) {
+	t.Helper()
+	prev := integrationStatusPollInterval
+	integrationStatusPollInterval = time.Millisecond
+	t.Cleanup(func() { integrationStatusPollInterval = prev })
+}
+
+func TestWaitForIntegrationStatus_pollsUntilConnected(t *testing.T) {
+	shortenIntegrationStatusPollInterval(t)
+	srv, calls := newMockIntegrationStatusServer(t, "pending", "pending", "connected")
+	defer srv.Close()
+
+	got, err := waitForIntegrationStatus(context.Background(), clientForServer(t, srv.URL), "accss_crdntl_1", time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Status != "connected" {
+		t.Errorf("got status %q, want %q", got.Status, "connected")
+	}
+	if *calls != 3 {
+		t.Errorf("got %d requests, want 3", *calls)
+	}
+}
+
+func TestWaitForIntegrationStatus_stopsOnError(t *testing.T) {
+	shortenIntegrationStatusPollInterval(t)
+	srv, _ := newMockIntegrationStatusServer(t, "pending", "error")
+	defer srv.Close()
+
+	got, err := waitForIntegrationStatus(context.Background(), clientForServer(t, srv.URL), "accss_crdntl_1", time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !integrationStatusFailed(got.Status) {
+		t.Errorf("expected failed status, got %q", got.Status)
+	}
+}
+
+func TestWaitForIntegrationStatus_timeout(t *testing.T) {
+	shortenIntegrationStatusPollInterval(t)
+	srv, _ := newMockIntegrationStatusServer(t, "pending")
+	defer srv.Close()
+
+	got, err := waitForIntegrationStatus(context.Background(), clientForServer(t, srv.URL), "accss_crdntl_1", 10*time.Millisecond)
+	if !errors.Is(err, errIntegrationStatusTimeout) {
+		t.Fatalf("expected timeout error, got %v", err)
+	}
+	if got == nil || got.Status != "pending" {
+		t.Errorf("expected last pending integration to be returned, got %+v", got)
+	}
+}
+
+func TestWaitForIntegrationStatus_apiError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	if _, err := waitForIntegrationStatus(context.Background(), clientForServer(t, srv.URL), "accss_crdntl_1", time.Minute); err == nil {
+		t.Fatal("expected error from API, got nil")
+	}
+}
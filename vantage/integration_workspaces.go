@@ -0,0 +1,48 @@
+package vantage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	integrationsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/integrations"
+)
+
+// applyIntegrationWorkspaces calls the UpdateIntegration endpoint with the
+// given workspace tokens and returns the set value to store in state. It is
+// shared by every integration resource; action names the operation in error
+// diagnostics.
+//
+// Note: the Vantage API requires workspace_tokens to be non-empty. Passing an
+// empty set is a no-op — the existing associations are preserved and the empty
+// set is returned as-is.
+func applyIntegrationWorkspaces(ctx context.Context, client *Client, action, integrationToken string, workspaces types.Set, diags *diag.Diagnostics) types.Set {
+	var tokens []string
+	diags.Append(workspaces.ElementsAs(ctx, &tokens, false)...)
+	if diags.HasError() {
+		return workspaces
+	}
+
+	// The API rejects an empty workspace_tokens array. Nothing to do.
+	if len(tokens) == 0 {
+		return workspaces
+	}
+
+	updateParams := integrationsv2.NewUpdateIntegrationParams()
+	updateParams.SetIntegrationToken(integrationToken)
+	updateParams.WithUpdateIntegration(&modelsv2.UpdateIntegration{
+		WorkspaceTokens: tokens,
+	})
+
+	out, err := client.V2.Integrations.UpdateIntegration(updateParams, client.Auth)
+	if err != nil {
+		handleError(action, diags, err)
+		return workspaces
+	}
+
+	// Return the workspace tokens as confirmed by the API response.
+	result, d := types.SetValueFrom(ctx, types.StringType, out.Payload.WorkspaceTokens)
+	diags.Append(d...)
+	return result
+}
@@ -15,7 +15,7 @@ type kubernetesEfficiencyReportModel resource_kubernetes_efficiency_report.Kuber
 
 func (r *kubernetesEfficiencyReportModel) applyPayload(ctx context.Context, payload *modelsv2.KubernetesEfficiencyReport, isDataSource bool) diag.Diagnostics {
 	r.CreatedAt = types.StringValue(payload.CreatedAt)
-	r.Filter = types.StringPointerValue(payload.Filter)
+	r.Filter = equivalentFilter(r.Filter, types.StringPointerValue(payload.Filter))
 	r.Title = types.StringValue(payload.Title)
 	r.Token = types.StringValue(payload.Token)
 	r.Id = types.StringValue(payload.Token)
@@ -0,0 +1,370 @@
+package vantage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/vantage-sh/terraform-provider-vantage/vantage/planmodifiers"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	integrationsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/integrations"
+)
+
+var (
+	_ resource.Resource                = (*KubernetesIntegrationResource)(nil)
+	_ resource.ResourceWithConfigure   = (*KubernetesIntegrationResource)(nil)
+	_ resource.ResourceWithImportState = (*KubernetesIntegrationResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*KubernetesIntegrationResource)(nil)
+)
+
+type KubernetesIntegrationResource struct{ client *Client }
+
+func NewKubernetesIntegrationResource() resource.Resource { return &KubernetesIntegrationResource{} }
+
+type KubernetesIntegrationResourceModel struct {
+	ClusterId        types.String `tfsdk:"cluster_id"`
+	Workspaces       types.Set    `tfsdk:"workspaces"`
+	AgentTokenSecret types.Object `tfsdk:"agent_token_secret"`
+	HelmValues       types.Object `tfsdk:"helm_values"`
+	Token            types.String `tfsdk:"token"`
+	Id               types.String `tfsdk:"id"`
+	Status           types.String `tfsdk:"status"`
+	LastUpdated      types.String `tfsdk:"last_updated"`
+}
+
+type kubernetesAgentTokenSecretModel struct {
+	Name types.String `tfsdk:"name"`
+	Key  types.String `tfsdk:"key"`
+}
+
+var kubernetesAgentHelmValuesAttrTypes = map[string]attr.Type{
+	"cluster_id":        types.StringType,
+	"token_secret_name": types.StringType,
+	"token_secret_key":  types.StringType,
+	"yaml":              types.StringType,
+}
+
+func (r *KubernetesIntegrationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *KubernetesIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kubernetes_integration"
+}
+
+func (r *KubernetesIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required: true,
+				// Remove when API supports updating.
+				PlanModifiers:       []planmodifier.String{planmodifiers.ImmutableAfterCreate("cluster_id")},
+				MarkdownDescription: "The id the Vantage Kubernetes agent reports the cluster as. Must be unique within the account. Cannot be changed after creation.",
+			},
+			"workspaces": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers:       []planmodifier.Set{planmodifiers.UseStateWhenEmpty()},
+				MarkdownDescription: "Workspace tokens to associate with the integration. Can be updated in-place. Note: the Vantage API requires at least one token — workspace associations cannot be fully removed once set.",
+			},
+			"agent_token_secret": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "An existing Kubernetes secret holding the Vantage API token the agent reports with. When set, `helm_values` points the agent at it. Only recorded in state; changing it doesn't call the API.",
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The name of the secret, in the namespace the agent is installed in.",
+					},
+					"key": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The key within the secret holding the token.",
+					},
+				},
+			},
+			"helm_values": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Values for the `vantage-kubernetes-agent` Helm chart that register the agent as this cluster. Pass `yaml` in `values` of a `helm_release`, or set the individual chart values from the other attributes. Without `agent_token_secret`, the agent's API token must be set separately, e.g. with `set_sensitive` on `agent.token`.",
+				Attributes: map[string]schema.Attribute{
+					"cluster_id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The chart's `agent.clusterID`.",
+					},
+					"token_secret_name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The chart's `agent.secret.name`, from `agent_token_secret`. Null when it is unset.",
+					},
+					"token_secret_key": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The chart's `agent.secret.key`, from `agent_token_secret`. Null when it is unset.",
+					},
+					"yaml": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "All of the values above, encoded as JSON (and therefore valid YAML).",
+					},
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "Unique token of the Kubernetes integration.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "Same as token.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "The status of the integration. Stays pending until the agent first reports. This is system-managed and cannot be configured.",
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date and time (UTC, ISO 8601) when the agent last reported data. Null if the agent has never reported.",
+			},
+		},
+		MarkdownDescription: "Manages a Kubernetes integration for a cluster running the Vantage Kubernetes agent.",
+	}
+}
+
+// ModifyPlan plans helm_values from cluster_id and agent_token_secret, so a
+// changed secret shows in the plan rather than only after apply.
+func (r *KubernetesIntegrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan KubernetesIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	values := plannedKubernetesAgentHelmValues(ctx, plan.ClusterId, plan.AgentTokenSecret, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("helm_values"), values)...)
+}
+
+func (r *KubernetesIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("token"), req, resp)
+}
+
+func (r *KubernetesIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data KubernetesIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := integrationsv2.NewCreateKubernetesIntegrationParams()
+	params.WithCreateKubernetesIntegration(&modelsv2.CreateKubernetesIntegration{
+		ClusterID: data.ClusterId.ValueStringPointer(),
+	})
+
+	out, err := r.client.V2.Integrations.CreateKubernetesIntegration(params, r.client.Auth)
+	if err != nil {
+		if e, ok := err.(*integrationsv2.CreateKubernetesIntegrationBadRequest); ok {
+			handleBadRequest("Create Kubernetes Integration", &resp.Diagnostics, e.GetPayload())
+			return
+		}
+		handleError("Create Kubernetes Integration", &resp.Diagnostics, err)
+		return
+	}
+
+	data.Token = types.StringValue(out.Payload.Token)
+	data.Id = types.StringValue(out.Payload.Token)
+	data.Status = types.StringValue(out.Payload.Status)
+	data.LastUpdated = types.StringPointerValue(out.Payload.LastUpdated)
+	data.HelmValues = kubernetesAgentHelmValues(ctx, data.ClusterId.ValueString(), data.AgentTokenSecret, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Associate workspaces immediately after creation if specified;
+	// otherwise resolve to an empty set so the value is always known after apply.
+	// Unlike the cloud integrations there is no status to wait for: the
+	// integration stays pending until the agent is installed.
+	if !data.Workspaces.IsNull() && !data.Workspaces.IsUnknown() {
+		data.Workspaces = applyIntegrationWorkspaces(ctx, r.client, "Update Kubernetes Integration Workspaces", out.Payload.Token, data.Workspaces, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			// The integration exists: persist it so that it is tainted
+			// rather than orphaned.
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	} else {
+		data.Workspaces, _ = types.SetValueFrom(ctx, types.StringType, []string{})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KubernetesIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state KubernetesIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := integrationsv2.NewGetIntegrationParams()
+	params.SetIntegrationToken(state.Token.ValueString())
+
+	out, err := r.client.V2.Integrations.GetIntegration(params, r.client.Auth)
+	if err != nil {
+		if _, ok := err.(*integrationsv2.GetIntegrationNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		handleError("Read Kubernetes Integration", &resp.Diagnostics, err)
+		return
+	}
+
+	state.Token = types.StringValue(out.Payload.Token)
+	state.Id = types.StringValue(out.Payload.Token)
+	state.Status = types.StringValue(out.Payload.Status)
+	state.LastUpdated = types.StringPointerValue(out.Payload.LastUpdated)
+
+	workspaces, diags := types.SetValueFrom(ctx, types.StringType, out.Payload.WorkspaceTokens)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Workspaces = workspaces
+
+	// The API reports the cluster id as the account identifier. Preserve the
+	// value in state and only seed it on a fresh import.
+	if state.ClusterId.IsNull() || state.ClusterId.ValueString() == "" {
+		if out.Payload.AccountIdentifier != nil {
+			state.ClusterId = types.StringValue(*out.Payload.AccountIdentifier)
+		}
+	}
+	if !state.ClusterId.IsNull() {
+		state.HelmValues = kubernetesAgentHelmValues(ctx, state.ClusterId.ValueString(), state.AgentTokenSecret, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *KubernetesIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// cluster_id is guarded by an ImmutableAfterCreate plan modifier and
+	// agent_token_secret only feeds helm_values, so only workspace changes
+	// reach the API.
+	var plan KubernetesIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state KubernetesIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Token = state.Token
+	plan.Id = state.Id
+	plan.Status = state.Status
+	plan.LastUpdated = state.LastUpdated
+	plan.HelmValues = kubernetesAgentHelmValues(ctx, plan.ClusterId.ValueString(), plan.AgentTokenSecret, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Workspaces = applyIntegrationWorkspaces(ctx, r.client, "Update Kubernetes Integration Workspaces", state.Token.ValueString(), plan.Workspaces, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *KubernetesIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state KubernetesIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := integrationsv2.NewDeleteIntegrationParams()
+	params.SetIntegrationToken(state.Token.ValueString())
+
+	_, err := r.client.V2.Integrations.DeleteIntegration(params, r.client.Auth)
+	if err != nil {
+		if _, ok := err.(*integrationsv2.DeleteIntegrationNotFound); ok {
+			return
+		}
+		handleError("Delete Kubernetes Integration", &resp.Diagnostics, err)
+	}
+}
+
+// plannedKubernetesAgentHelmValues returns the helm_values to plan, unknown
+// until cluster_id and every attribute of agent_token_secret are known.
+func plannedKubernetesAgentHelmValues(ctx context.Context, clusterID types.String, secret types.Object, diags *diag.Diagnostics) types.Object {
+	unknown := types.ObjectUnknown(kubernetesAgentHelmValuesAttrTypes)
+	if clusterID.IsUnknown() {
+		return unknown
+	}
+	v, err := secret.ToTerraformValue(ctx)
+	if err != nil || !v.IsFullyKnown() {
+		return unknown
+	}
+	return kubernetesAgentHelmValues(ctx, clusterID.ValueString(), secret, diags)
+}
+
+// kubernetesAgentHelmValues renders the vantage-kubernetes-agent chart values
+// that register the agent as clusterID, reading its API token from secret
+// when it is set. The output is deterministic so that it never produces a
+// diff for unchanged inputs.
+func kubernetesAgentHelmValues(ctx context.Context, clusterID string, secret types.Object, diags *diag.Diagnostics) types.Object {
+	agent := map[string]interface{}{
+		"clusterID": clusterID,
+	}
+	secretName, secretKey := types.StringNull(), types.StringNull()
+	if !secret.IsNull() && !secret.IsUnknown() {
+		var s kubernetesAgentTokenSecretModel
+		diags.Append(secret.As(ctx, &s, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return types.ObjectNull(kubernetesAgentHelmValuesAttrTypes)
+		}
+		secretName, secretKey = s.Name, s.Key
+		agent["secret"] = map[string]interface{}{
+			"name": s.Name.ValueString(),
+			"key":  s.Key.ValueString(),
+		}
+	}
+
+	encoded, err := json.Marshal(map[string]interface{}{"agent": agent})
+	if err != nil {
+		diags.AddError(
+			"Unable to Render Helm Values",
+			fmt.Sprintf("Could not encode Helm values for cluster %q: %s", clusterID, err),
+		)
+		return types.ObjectNull(kubernetesAgentHelmValuesAttrTypes)
+	}
+
+	values, d := types.ObjectValue(kubernetesAgentHelmValuesAttrTypes, map[string]attr.Value{
+		"cluster_id":        types.StringValue(clusterID),
+		"token_secret_name": secretName,
+		"token_secret_key":  secretKey,
+		"yaml":              types.StringValue(string(encoded)),
+	})
+	diags.Append(d...)
+	return values
+}
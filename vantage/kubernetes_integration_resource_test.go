@@ -0,0 +1,106 @@
+package vantage
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type kubernetesAgentChartValues struct {
+	Agent struct {
+		ClusterID string `json:"clusterID"`
+		Secret    *struct {
+			Name string `json:"name"`
+			Key  string `json:"key"`
+		} `json:"secret"`
+	} `json:"agent"`
+}
+
+func TestKubernetesAgentHelmValues(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	secretType := map[string]attr.Type{"name": types.StringType, "key": types.StringType}
+	got := kubernetesAgentHelmValues(ctx, "prod-us-east-1", types.ObjectNull(secretType), &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	attrs := got.Attributes()
+	if v := attrs["cluster_id"].(types.String).ValueString(); v != "prod-us-east-1" {
+		t.Errorf("got cluster_id %q, want %q", v, "prod-us-east-1")
+	}
+	if !attrs["token_secret_name"].IsNull() || !attrs["token_secret_key"].IsNull() {
+		t.Errorf("expected null secret attributes without agent_token_secret, got %v", attrs)
+	}
+
+	var values kubernetesAgentChartValues
+	if err := json.Unmarshal([]byte(attrs["yaml"].(types.String).ValueString()), &values); err != nil {
+		t.Fatalf("helm_values.yaml is not valid JSON: %v", err)
+	}
+	if values.Agent.ClusterID != "prod-us-east-1" {
+		t.Errorf("got agent.clusterID %q, want %q", values.Agent.ClusterID, "prod-us-east-1")
+	}
+	if values.Agent.Secret != nil {
+		t.Errorf("expected no agent.secret, got %+v", values.Agent.Secret)
+	}
+
+	if again := kubernetesAgentHelmValues(ctx, "prod-us-east-1", types.ObjectNull(secretType), &diags); !again.Equal(got) {
+		t.Errorf("helm_values is not deterministic: %v vs %v", got, again)
+	}
+}
+
+func TestKubernetesAgentHelmValuesTokenSecret(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	secret, d := types.ObjectValue(map[string]attr.Type{"name": types.StringType, "key": types.StringType}, map[string]attr.Value{
+		"name": types.StringValue("vantage-api-token"),
+		"key":  types.StringValue("token"),
+	})
+	diags.Append(d...)
+	got := kubernetesAgentHelmValues(ctx, "prod-us-east-1", secret, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	attrs := got.Attributes()
+	if v := attrs["token_secret_name"].(types.String).ValueString(); v != "vantage-api-token" {
+		t.Errorf("got token_secret_name %q, want %q", v, "vantage-api-token")
+	}
+	if v := attrs["token_secret_key"].(types.String).ValueString(); v != "token" {
+		t.Errorf("got token_secret_key %q, want %q", v, "token")
+	}
+
+	var values kubernetesAgentChartValues
+	if err := json.Unmarshal([]byte(attrs["yaml"].(types.String).ValueString()), &values); err != nil {
+		t.Fatalf("helm_values.yaml is not valid JSON: %v", err)
+	}
+	if values.Agent.Secret == nil || values.Agent.Secret.Name != "vantage-api-token" || values.Agent.Secret.Key != "token" {
+		t.Errorf("got agent.secret %+v, want vantage-api-token/token", values.Agent.Secret)
+	}
+}
+
+func TestPlannedKubernetesAgentHelmValuesUnknownSecretName(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	secret, d := types.ObjectValue(map[string]attr.Type{"name": types.StringType, "key": types.StringType}, map[string]attr.Value{
+		"name": types.StringUnknown(),
+		"key":  types.StringValue("token"),
+	})
+	diags.Append(d...)
+	got := plannedKubernetesAgentHelmValues(ctx, types.StringValue("prod-us-east-1"), secret, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !got.IsUnknown() {
+		t.Errorf("expected unknown helm_values for an unknown secret name, got %v", got)
+	}
+
+	known := plannedKubernetesAgentHelmValues(ctx, types.StringValue("prod-us-east-1"), types.ObjectNull(secret.AttributeTypes(ctx)), &diags)
+	if known.IsUnknown() || known.IsNull() {
+		t.Errorf("expected known helm_values without a secret, got %v", known)
+	}
+}
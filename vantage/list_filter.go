@@ -0,0 +1,161 @@
+package vantage
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listFilterConfig holds the filter arguments of a plural data source as
+// configured. Data sources leave the fields they don't offer null.
+type listFilterConfig struct {
+	WorkspaceToken types.String
+	FolderToken    types.String
+	TitleRegex     types.String
+	CreatedByToken types.String
+	CreatedAfter   types.String
+	CreatedBefore  types.String
+}
+
+// listFilter is the parsed form of listFilterConfig. Zero fields match
+// everything.
+type listFilter struct {
+	WorkspaceToken string
+	FolderToken    string
+	TitleRegex     *regexp.Regexp
+	CreatedByToken string
+	CreatedAfter   time.Time
+	CreatedBefore  time.Time
+}
+
+// listFilterItem is the part of a listed object that filters are applied to.
+type listFilterItem struct {
+	WorkspaceTokens []string
+	FolderToken     *string
+	// Titles are matched by TitleRegex; the item matches if any of them does.
+	Titles         []string
+	CreatedByToken *string
+	CreatedAt      string
+}
+
+// listFilterAttributes returns the schema attributes for the named filter
+// arguments. titleAttr is the name of the regex filter, e.g. "title_regex",
+// and noun is used in descriptions, e.g. "Cost Reports".
+func listFilterAttributes(noun, titleAttr string, names ...string) map[string]schema.Attribute {
+	all := map[string]schema.Attribute{
+		"workspace_token": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("Only return %s in this workspace.", noun),
+		},
+		"folder_token": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("Only return %s in this folder.", noun),
+		},
+		"created_by_token": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("Only return %s created by the User with this token.", noun),
+		},
+		"created_after": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("Only return %s created at or after this date (`YYYY-MM-DD`) or time (RFC 3339).", noun),
+		},
+		"created_before": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("Only return %s created before this date (`YYYY-MM-DD`) or time (RFC 3339).", noun),
+		},
+	}
+	if titleAttr != "" {
+		all[titleAttr] = schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("Only return %s whose %s matches this regular expression (RE2 syntax).", noun, listFilterTitleNoun(titleAttr)),
+		}
+	}
+
+	attrs := make(map[string]schema.Attribute, len(names))
+	for _, name := range names {
+		attrs[name] = all[name]
+	}
+	return attrs
+}
+
+func listFilterTitleNoun(titleAttr string) string {
+	switch titleAttr {
+	case "name_regex":
+		return "name"
+	default:
+		return "title"
+	}
+}
+
+// parseListFilter validates and parses the configured filters. titleAttr is
+// the attribute the title regex was configured on, for error paths.
+func parseListFilter(config listFilterConfig, titleAttr string, diags *diag.Diagnostics) listFilter {
+	filter := listFilter{
+		WorkspaceToken: config.WorkspaceToken.ValueString(),
+		FolderToken:    config.FolderToken.ValueString(),
+		CreatedByToken: config.CreatedByToken.ValueString(),
+	}
+
+	if v := config.TitleRegex.ValueString(); v != "" {
+		re, err := regexp.Compile(v)
+		if err != nil {
+			diags.AddAttributeError(path.Root(titleAttr), "Invalid Regular Expression", fmt.Sprintf("%q is not a valid regular expression: %s", v, err))
+		}
+		filter.TitleRegex = re
+	}
+
+	filter.CreatedAfter = parseListFilterTime(config.CreatedAfter, "created_after", diags)
+	filter.CreatedBefore = parseListFilterTime(config.CreatedBefore, "created_before", diags)
+	return filter
+}
+
+func parseListFilterTime(value types.String, attr string, diags *diag.Diagnostics) time.Time {
+	v := value.ValueString()
+	if v == "" {
+		return time.Time{}
+	}
+	if t, err := time.Parse(time.DateOnly, v); err == nil {
+		return t
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		diags.AddAttributeError(path.Root(attr), "Invalid Date", fmt.Sprintf("%q must be a date (YYYY-MM-DD) or an RFC 3339 time.", v))
+	}
+	return t
+}
+
+// Matches reports whether item passes every configured filter. Items without
+// a creation time or creator never match the corresponding filters.
+func (f listFilter) Matches(item listFilterItem) bool {
+	if f.WorkspaceToken != "" && !slices.Contains(item.WorkspaceTokens, f.WorkspaceToken) {
+		return false
+	}
+	if f.FolderToken != "" && (item.FolderToken == nil || *item.FolderToken != f.FolderToken) {
+		return false
+	}
+	if f.CreatedByToken != "" && (item.CreatedByToken == nil || *item.CreatedByToken != f.CreatedByToken) {
+		return false
+	}
+	if f.TitleRegex != nil && !slices.ContainsFunc(item.Titles, f.TitleRegex.MatchString) {
+		return false
+	}
+	if !f.CreatedAfter.IsZero() || !f.CreatedBefore.IsZero() {
+		createdAt, err := time.Parse(time.RFC3339, item.CreatedAt)
+		if err != nil {
+			return false
+		}
+		if !f.CreatedAfter.IsZero() && createdAt.Before(f.CreatedAfter) {
+			return false
+		}
+		if !f.CreatedBefore.IsZero() && !createdAt.Before(f.CreatedBefore) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,65 @@
+package vantage
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestListFilter_Matches(t *testing.T) {
+	folder := "fldr_1"
+	creator := "usr_1"
+	item := listFilterItem{
+		WorkspaceTokens: []string{"wrkspc_1"},
+		FolderToken:     &folder,
+		Titles:          []string{"Production Spend"},
+		CreatedByToken:  &creator,
+		CreatedAt:       "2024-03-15T12:00:00Z",
+	}
+
+	tests := []struct {
+		name   string
+		config listFilterConfig
+		want   bool
+	}{
+		{"no filters", listFilterConfig{}, true},
+		{"workspace", listFilterConfig{WorkspaceToken: types.StringValue("wrkspc_1")}, true},
+		{"other workspace", listFilterConfig{WorkspaceToken: types.StringValue("wrkspc_2")}, false},
+		{"folder", listFilterConfig{FolderToken: types.StringValue("fldr_1")}, true},
+		{"other folder", listFilterConfig{FolderToken: types.StringValue("fldr_2")}, false},
+		{"title regex", listFilterConfig{TitleRegex: types.StringValue("^Prod")}, true},
+		{"title regex miss", listFilterConfig{TitleRegex: types.StringValue("^Staging")}, false},
+		{"creator", listFilterConfig{CreatedByToken: types.StringValue("usr_1")}, true},
+		{"other creator", listFilterConfig{CreatedByToken: types.StringValue("usr_2")}, false},
+		{"created after date", listFilterConfig{CreatedAfter: types.StringValue("2024-03-15")}, true},
+		{"created after later date", listFilterConfig{CreatedAfter: types.StringValue("2024-03-16")}, false},
+		{"created before date", listFilterConfig{CreatedBefore: types.StringValue("2024-03-16")}, true},
+		{"created before same day", listFilterConfig{CreatedBefore: types.StringValue("2024-03-15")}, false},
+		{"created before time", listFilterConfig{CreatedBefore: types.StringValue("2024-03-15T12:00:01Z")}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			filter := parseListFilter(tt.config, "title_regex", &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got := filter.Matches(item); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseListFilter_invalid(t *testing.T) {
+	var diags diag.Diagnostics
+	parseListFilter(listFilterConfig{
+		TitleRegex:   types.StringValue("("),
+		CreatedAfter: types.StringValue("March 2024"),
+	}, "title_regex", &diags)
+	if diags.ErrorsCount() != 2 {
+		t.Errorf("expected 2 errors, got %v", diags)
+	}
+}
@@ -0,0 +1,229 @@
+package vantage
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = (*lookupDataSource)(nil)
+	_ datasource.DataSourceWithConfigure        = (*lookupDataSource)(nil)
+	_ datasource.DataSourceWithConfigValidators = (*lookupDataSource)(nil)
+)
+
+// lookupObject is the part of a Vantage object that singular lookups match
+// on and return.
+type lookupObject struct {
+	Token string
+	Title string
+	// WorkspaceTokens holds the object's workspace, or every workspace for
+	// objects such as teams that can belong to several.
+	WorkspaceTokens []string
+	FolderToken     *string
+}
+
+// lookupSpec describes a singular lookup data source. Adding one only
+// requires a spec and a list function; matching, error reporting and the
+// schema are shared.
+type lookupSpec struct {
+	// TypeName is appended to the provider type name, e.g. "cost_report".
+	TypeName string
+	// DisplayName is used in descriptions and diagnostics, e.g. "Cost Report".
+	DisplayName string
+	// TitleAttribute names the title attribute, "title" or "name" to match the
+	// corresponding resource.
+	TitleAttribute string
+	// SupportsFolder adds the folder_token filter for objects kept in folders.
+	SupportsFolder bool
+	List           func(client *Client) ([]lookupObject, error)
+}
+
+type lookupDataSource struct {
+	spec   lookupSpec
+	client *Client
+}
+
+func newLookupDataSource(spec lookupSpec) datasource.DataSource {
+	return &lookupDataSource{spec: spec}
+}
+
+func (d *lookupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*Client)
+}
+
+func (d *lookupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.spec.TypeName
+}
+
+func (d *lookupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	name := d.spec.DisplayName
+	attrs := map[string]schema.Attribute{
+		"token": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("The token of the %s to look up. Exactly one of `token` or `%s` must be set.", name, d.spec.TitleAttribute),
+		},
+		d.spec.TitleAttribute: schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("The %s of the %s to look up. Must match exactly one %s after the other filters are applied.", d.spec.TitleAttribute, name, name),
+		},
+		"workspace_token": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("Only match a %s in this workspace. Also populated as an output with the workspace token of the matched %s.", name, name),
+		},
+	}
+	if d.spec.SupportsFolder {
+		attrs["folder_token"] = schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("Only match a %s in this folder. Also populated as an output with the folder token of the matched %s.", name, name),
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Looks up a single %s by token, or by %s narrowed by the optional filters. Fails if no %s or more than one matches.", name, d.spec.TitleAttribute, name),
+		Attributes:          attrs,
+	}
+}
+
+func (d *lookupDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("token"),
+			path.MatchRoot(d.spec.TitleAttribute),
+		),
+	}
+}
+
+// lookupFilter holds the configured values a lookup matches on. Empty fields
+// are not filtered on.
+type lookupFilter struct {
+	Token          string
+	Title          string
+	WorkspaceToken string
+	FolderToken    string
+}
+
+func (d *lookupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var token, title, workspaceToken, folderToken types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("token"), &token)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(d.spec.TitleAttribute), &title)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("workspace_token"), &workspaceToken)...)
+	if d.spec.SupportsFolder {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("folder_token"), &folderToken)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objects, err := d.spec.List(d.client)
+	if err != nil {
+		handleError(fmt.Sprintf("Read %s", d.spec.DisplayName), &resp.Diagnostics, err)
+		return
+	}
+
+	filter := lookupFilter{
+		Token:          token.ValueString(),
+		Title:          title.ValueString(),
+		WorkspaceToken: workspaceToken.ValueString(),
+		FolderToken:    folderToken.ValueString(),
+	}
+	matches := matchLookupObjects(objects, filter)
+
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("%s Not Found", d.spec.DisplayName),
+			fmt.Sprintf("No %s %s was found.", d.spec.DisplayName, d.describeFilter(filter)),
+		)
+		return
+	case 1:
+	default:
+		tokens := make([]string, 0, len(matches))
+		for _, m := range matches {
+			tokens = append(tokens, m.Token)
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Multiple %ss Found", d.spec.DisplayName),
+			fmt.Sprintf("%d %ss %s were found (%s). Narrow the lookup with workspace_token%s, or look it up by token.",
+				len(matches), d.spec.DisplayName, d.describeFilter(filter), strings.Join(tokens, ", "), d.folderHint()),
+		)
+		return
+	}
+
+	match := matches[0]
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("token"), types.StringValue(match.Token))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(d.spec.TitleAttribute), types.StringValue(match.Title))...)
+
+	// Keep a configured workspace filter as-is; otherwise report the
+	// object's workspace when it has exactly one.
+	if filter.WorkspaceToken == "" {
+		workspaceToken = types.StringNull()
+		if len(match.WorkspaceTokens) == 1 {
+			workspaceToken = types.StringValue(match.WorkspaceTokens[0])
+		}
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_token"), workspaceToken)...)
+	if d.spec.SupportsFolder {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("folder_token"), types.StringPointerValue(match.FolderToken))...)
+	}
+}
+
+func (d *lookupDataSource) describeFilter(filter lookupFilter) string {
+	var parts []string
+	if filter.Token != "" {
+		parts = append(parts, fmt.Sprintf("with token %q", filter.Token))
+	}
+	if filter.Title != "" {
+		parts = append(parts, fmt.Sprintf("with %s %q", d.spec.TitleAttribute, filter.Title))
+	}
+	if filter.WorkspaceToken != "" {
+		parts = append(parts, fmt.Sprintf("in workspace %q", filter.WorkspaceToken))
+	}
+	if filter.FolderToken != "" {
+		parts = append(parts, fmt.Sprintf("in folder %q", filter.FolderToken))
+	}
+	return strings.Join(parts, " ")
+}
+
+func (d *lookupDataSource) folderHint() string {
+	if d.spec.SupportsFolder {
+		return " or folder_token"
+	}
+	return ""
+}
+
+// matchLookupObjects returns the objects that match every non-empty field of
+// filter.
+func matchLookupObjects(objects []lookupObject, filter lookupFilter) []lookupObject {
+	var matches []lookupObject
+	for _, o := range objects {
+		if filter.Token != "" && o.Token != filter.Token {
+			continue
+		}
+		if filter.Title != "" && o.Title != filter.Title {
+			continue
+		}
+		if filter.WorkspaceToken != "" && !slices.Contains(o.WorkspaceTokens, filter.WorkspaceToken) {
+			continue
+		}
+		if filter.FolderToken != "" && (o.FolderToken == nil || *o.FolderToken != filter.FolderToken) {
+			continue
+		}
+		matches = append(matches, o)
+	}
+	return matches
+}
@@ -0,0 +1,41 @@
+package vantage
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMatchLookupObjects(t *testing.T) {
+	folder := "fldr_1"
+	objects := []lookupObject{
+		{Token: "rprt_a", Title: "Spend", WorkspaceTokens: []string{"wrkspc_1"}, FolderToken: &folder},
+		{Token: "rprt_b", Title: "Spend", WorkspaceTokens: []string{"wrkspc_2"}},
+		{Token: "rprt_c", Title: "Other", WorkspaceTokens: []string{"wrkspc_1"}},
+		{Token: "team_d", Title: "Platform", WorkspaceTokens: []string{"wrkspc_1", "wrkspc_2"}},
+	}
+
+	tests := []struct {
+		name   string
+		filter lookupFilter
+		want   []string
+	}{
+		{"by token", lookupFilter{Token: "rprt_c"}, []string{"rprt_c"}},
+		{"ambiguous title", lookupFilter{Title: "Spend"}, []string{"rprt_a", "rprt_b"}},
+		{"title and workspace", lookupFilter{Title: "Spend", WorkspaceToken: "wrkspc_2"}, []string{"rprt_b"}},
+		{"title and folder", lookupFilter{Title: "Spend", FolderToken: "fldr_1"}, []string{"rprt_a"}},
+		{"any of several workspaces", lookupFilter{Title: "Platform", WorkspaceToken: "wrkspc_2"}, []string{"team_d"}},
+		{"no match", lookupFilter{Title: "Missing"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, o := range matchLookupObjects(objects, tt.filter) {
+				got = append(got, o.Token)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("matchLookupObjects() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package vantage
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	budgetsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/budgets"
+	dashboardsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/dashboards"
+	resourcereportsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/resource_reports"
+	filtersv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/saved_filters"
+	segmentsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/segments"
+	teamsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/teams"
+)
+
+func NewCostReportDataSource() datasource.DataSource {
+	return newLookupDataSource(lookupSpec{
+		TypeName:       "cost_report",
+		DisplayName:    "Cost Report",
+		TitleAttribute: "title",
+		SupportsFolder: true,
+		List: func(client *Client) ([]lookupObject, error) {
+			reports, err := fetchAllCostReports(client, nil)
+			if err != nil {
+				return nil, err
+			}
+			objects := make([]lookupObject, 0, len(reports))
+			for _, r := range reports {
+				objects = append(objects, lookupObject{
+					Token:           r.Token,
+					Title:           r.Title,
+					WorkspaceTokens: []string{r.WorkspaceToken},
+					FolderToken:     r.FolderToken,
+				})
+			}
+			return objects, nil
+		},
+	})
+}
+
+func NewSavedFilterDataSource() datasource.DataSource {
+	return newLookupDataSource(lookupSpec{
+		TypeName:       "saved_filter",
+		DisplayName:    "Saved Filter",
+		TitleAttribute: "title",
+		List: func(client *Client) ([]lookupObject, error) {
+			filters, err := fetchAllPages(func(limit int32, page *int32) ([]*modelsv2.SavedFilter, *modelsv2.Links, error) {
+				params := filtersv2.NewGetSavedFiltersParams()
+				params.SetLimit(&limit)
+				params.SetPage(page)
+				out, err := client.V2.SavedFilters.GetSavedFilters(params, client.Auth)
+				if err != nil {
+					return nil, nil, err
+				}
+				return out.Payload.SavedFilters, out.Payload.Links, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			objects := make([]lookupObject, 0, len(filters))
+			for _, f := range filters {
+				objects = append(objects, lookupObject{
+					Token:           f.Token,
+					Title:           f.Title,
+					WorkspaceTokens: []string{f.WorkspaceToken},
+				})
+			}
+			return objects, nil
+		},
+	})
+}
+
+func NewDashboardDataSource() datasource.DataSource {
+	return newLookupDataSource(lookupSpec{
+		TypeName:       "dashboard",
+		DisplayName:    "Dashboard",
+		TitleAttribute: "title",
+		List: func(client *Client) ([]lookupObject, error) {
+			dashboards, err := fetchAllPages(func(limit int32, page *int32) ([]*modelsv2.Dashboard, *modelsv2.Links, error) {
+				params := dashboardsv2.NewGetDashboardsParams()
+				params.SetLimit(&limit)
+				params.SetPage(page)
+				out, err := client.V2.Dashboards.GetDashboards(params, client.Auth)
+				if err != nil {
+					return nil, nil, err
+				}
+				return out.Payload.Dashboards, out.Payload.Links, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			objects := make([]lookupObject, 0, len(dashboards))
+			for _, d := range dashboards {
+				objects = append(objects, lookupObject{
+					Token:           d.Token,
+					Title:           d.Title,
+					WorkspaceTokens: []string{d.WorkspaceToken},
+				})
+			}
+			return objects, nil
+		},
+	})
+}
+
+func NewSegmentDataSource() datasource.DataSource {
+	return newLookupDataSource(lookupSpec{
+		TypeName:       "segment",
+		DisplayName:    "Segment",
+		TitleAttribute: "title",
+		List: func(client *Client) ([]lookupObject, error) {
+			segments, err := fetchAllPages(func(limit int32, page *int32) ([]*modelsv2.Segment, *modelsv2.Links, error) {
+				params := segmentsv2.NewGetSegmentsParams()
+				params.SetLimit(&limit)
+				params.SetPage(page)
+				out, err := client.V2.Segments.GetSegments(params, client.Auth)
+				if err != nil {
+					return nil, nil, err
+				}
+				return out.Payload.Segments, out.Payload.Links, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			objects := make([]lookupObject, 0, len(segments))
+			for _, s := range segments {
+				objects = append(objects, lookupObject{
+					Token:           s.Token,
+					Title:           s.Title,
+					WorkspaceTokens: []string{s.WorkspaceToken},
+				})
+			}
+			return objects, nil
+		},
+	})
+}
+
+func NewBudgetDataSource() datasource.DataSource {
+	return newLookupDataSource(lookupSpec{
+		TypeName:       "budget",
+		DisplayName:    "Budget",
+		TitleAttribute: "name",
+		List: func(client *Client) ([]lookupObject, error) {
+			budgets, err := fetchAllPages(func(limit int32, page *int32) ([]*modelsv2.Budget, *modelsv2.Links, error) {
+				params := budgetsv2.NewGetBudgetsParams()
+				params.SetLimit(&limit)
+				params.SetPage(page)
+				out, err := client.V2.Budgets.GetBudgets(params, client.Auth)
+				if err != nil {
+					return nil, nil, err
+				}
+				return out.Payload.Budgets, out.Payload.Links, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			objects := make([]lookupObject, 0, len(budgets))
+			for _, b := range budgets {
+				name := ""
+				if b.Name != nil {
+					name = *b.Name
+				}
+				objects = append(objects, lookupObject{
+					Token:           b.Token,
+					Title:           name,
+					WorkspaceTokens: []string{b.WorkspaceToken},
+				})
+			}
+			return objects, nil
+		},
+	})
+}
+
+func NewTeamDataSource() datasource.DataSource {
+	return newLookupDataSource(lookupSpec{
+		TypeName:       "team",
+		DisplayName:    "Team",
+		TitleAttribute: "name",
+		List: func(client *Client) ([]lookupObject, error) {
+			teams, err := fetchAllPages(func(limit int32, page *int32) ([]*modelsv2.Team, *modelsv2.Links, error) {
+				params := teamsv2.NewGetTeamsParams()
+				params.SetLimit(&limit)
+				params.SetPage(page)
+				out, err := client.V2.Teams.GetTeams(params, client.Auth)
+				if err != nil {
+					return nil, nil, err
+				}
+				return out.Payload.Teams, out.Payload.Links, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			objects := make([]lookupObject, 0, len(teams))
+			for _, t := range teams {
+				objects = append(objects, lookupObject{
+					Token:           t.Token,
+					Title:           t.Name,
+					WorkspaceTokens: t.WorkspaceTokens,
+				})
+			}
+			return objects, nil
+		},
+	})
+}
+
+func NewResourceReportDataSource() datasource.DataSource {
+	return newLookupDataSource(lookupSpec{
+		TypeName:       "resource_report",
+		DisplayName:    "Resource Report",
+		TitleAttribute: "title",
+		List: func(client *Client) ([]lookupObject, error) {
+			reports, err := fetchAllPages(func(limit int32, page *int32) ([]*modelsv2.ResourceReport, *modelsv2.Links, error) {
+				params := resourcereportsv2.NewGetResourceReportsParams()
+				params.SetLimit(&limit)
+				params.SetPage(page)
+				out, err := client.V2.ResourceReports.GetResourceReports(params, client.Auth)
+				if err != nil {
+					return nil, nil, err
+				}
+				return out.Payload.ResourceReports, out.Payload.Links, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			objects := make([]lookupObject, 0, len(reports))
+			for _, r := range reports {
+				objects = append(objects, lookupObject{
+					Token:           r.Token,
+					Title:           r.Title,
+					WorkspaceTokens: []string{r.WorkspaceToken},
+				})
+			}
+			return objects, nil
+		},
+	})
+}
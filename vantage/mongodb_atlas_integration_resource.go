@@ -0,0 +1,39 @@
+package vantage
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	integrationsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/integrations"
+)
+
+func NewMongoDBAtlasIntegrationResource() resource.Resource {
+	return newSaasIntegrationResource(saasIntegrationSpec{
+		TypeName:    "mongodb_atlas_integration",
+		DisplayName: "MongoDB Atlas",
+		Description: "Manages a MongoDB Atlas integration, which imports the invoices of a MongoDB Atlas organization.",
+		Fields: []saasIntegrationField{
+			{Name: "organization_id", Description: "The id of the MongoDB Atlas organization."},
+			{Name: "public_key", Description: "The public key of an organization API key with the Organization Billing Viewer role."},
+			{Name: "private_key", Description: "The private key of the organization API key.", Sensitive: true},
+		},
+		Create: func(client *Client, values map[string]*string) (*modelsv2.Integration, error) {
+			params := integrationsv2.NewCreateMongoDBAtlasIntegrationParams()
+			params.WithCreateMongoDBAtlasIntegration(&modelsv2.CreateMongoDBAtlasIntegration{
+				OrganizationID: values["organization_id"],
+				PublicKey:      values["public_key"],
+				PrivateKey:     values["private_key"],
+			})
+			out, err := client.V2.Integrations.CreateMongoDBAtlasIntegration(params, client.Auth)
+			if err != nil {
+				return nil, err
+			}
+			return out.Payload, nil
+		},
+		BadRequest: func(err error) *modelsv2.Errors {
+			if e, ok := err.(*integrationsv2.CreateMongoDBAtlasIntegrationBadRequest); ok {
+				return e.GetPayload()
+			}
+			return nil
+		},
+	})
+}
@@ -20,7 +20,7 @@ func (m *networkFlowReportResourceModel) applyPayload(ctx context.Context, paylo
 	m.DateInterval = types.StringPointerValue(payload.DateInterval)
 	m.Default = types.BoolValue(payload.Default)
 	m.EndDate = types.StringPointerValue(payload.EndDate)
-	m.Filter = types.StringPointerValue(payload.Filter)
+	m.Filter = equivalentFilter(m.Filter, types.StringPointerValue(payload.Filter))
 	m.FlowDirection = types.StringPointerValue(payload.FlowDirection)
 	m.FlowWeight = types.StringValue(payload.FlowWeight)
 	m.StartDate = types.StringPointerValue(payload.StartDate)
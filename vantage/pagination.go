@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
 )
 
 // pageFromURL extracts the "page" query parameter from a pagination link URL.
@@ -22,3 +24,32 @@ func pageFromURL(rawURL string) (int32, error) {
 	}
 	return int32(n), nil
 }
+
+// fetchAllPages calls fetch with increasing page numbers until links.next is
+// nil, collecting the items of every page.
+func fetchAllPages[T any](fetch func(limit int32, page *int32) ([]T, *modelsv2.Links, error)) ([]T, error) {
+	limit := int32(1000)
+	var all []T
+	var page *int32
+
+	for {
+		items, links, err := fetch(limit, page)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, items...)
+
+		if links == nil || links.Next == nil {
+			break
+		}
+
+		nextPage, err := pageFromURL(*links.Next)
+		if err != nil {
+			return nil, fmt.Errorf("parsing next page from links.next %q: %w", *links.Next, err)
+		}
+		page = &nextPage
+	}
+
+	return all, nil
+}
@@ -1,6 +1,11 @@
 package vantage
 
-import "testing"
+import (
+	"fmt"
+	"testing"
+
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+)
 
 func TestPageFromURL(t *testing.T) {
 	tests := []struct {
@@ -54,3 +59,35 @@ func TestPageFromURL(t *testing.T) {
 		})
 	}
 }
+
+func TestFetchAllPages_followsLinks(t *testing.T) {
+	pages := [][]string{{"a", "b"}, {"c"}, {"d"}}
+	var requested []int32
+
+	got, err := fetchAllPages(func(limit int32, page *int32) ([]string, *modelsv2.Links, error) {
+		if limit != 1000 {
+			t.Errorf("expected limit 1000, got %d", limit)
+		}
+		num := int32(1)
+		if page != nil {
+			num = *page
+		}
+		requested = append(requested, num)
+
+		var links *modelsv2.Links
+		if int(num) < len(pages) {
+			next := fmt.Sprintf("https://api.vantage.sh/v2/costs?limit=1000&page=%d", num+1)
+			links = &modelsv2.Links{Next: &next}
+		}
+		return pages[num-1], links, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fmt.Sprint(got) != "[a b c d]" {
+		t.Errorf("expected all items across pages, got %v", got)
+	}
+	if fmt.Sprint(requested) != "[1 2 3]" {
+		t.Errorf("expected pages [1 2 3] to be requested, got %v", requested)
+	}
+}
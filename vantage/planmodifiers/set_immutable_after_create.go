@@ -0,0 +1,49 @@
+package planmodifiers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// SetImmutableAfterCreate is the planmodifier.Set counterpart of
+// ImmutableAfterCreate. It is meant for sets that are only sent to the API
+// on create, such as initial assignments: on update, a configured value that
+// differs from state produces a warning and the plan keeps the state value.
+func SetImmutableAfterCreate(fieldName string) planmodifier.Set {
+	return setImmutableAfterCreate{fieldName: fieldName}
+}
+
+type setImmutableAfterCreate struct{ fieldName string }
+
+func (m setImmutableAfterCreate) Description(_ context.Context) string {
+	return fmt.Sprintf("The %q field is only applied when the resource is created.", m.fieldName)
+}
+
+func (m setImmutableAfterCreate) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m setImmutableAfterCreate) PlanModifySet(_ context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+	// Allow any value on create, and after import (state is null).
+	if req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+		return
+	}
+
+	if req.ConfigValue.Equal(req.StateValue) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		req.Path,
+		fmt.Sprintf("Cannot update %q", m.fieldName),
+		fmt.Sprintf(
+			"The %q field is only applied when the resource is created, so the change will not be applied. "+
+				"To use a different value, destroy and recreate the resource.",
+			m.fieldName,
+		),
+	)
+
+	resp.PlanValue = req.StateValue
+}
@@ -0,0 +1,208 @@
+package vantage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	productsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/products"
+)
+
+var (
+	_ datasource.DataSource              = (*productPricesDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*productPricesDataSource)(nil)
+)
+
+func NewProductPricesDataSource() datasource.DataSource {
+	return &productPricesDataSource{}
+}
+
+type productPriceItemModel struct {
+	Id             types.String  `tfsdk:"id"`
+	Region         types.String  `tfsdk:"region"`
+	Unit           types.String  `tfsdk:"unit"`
+	Amount         types.Float64 `tfsdk:"amount"`
+	Currency       types.String  `tfsdk:"currency"`
+	PurchaseOption types.String  `tfsdk:"purchase_option"`
+	Details        types.Map     `tfsdk:"details"`
+}
+
+type productPricesDataSourceModel struct {
+	ProductId      types.String            `tfsdk:"product_id"`
+	Region         types.String            `tfsdk:"region"`
+	PurchaseOption types.String            `tfsdk:"purchase_option"`
+	Prices         []productPriceItemModel `tfsdk:"prices"`
+}
+
+type productPricesDataSource struct {
+	client *Client
+}
+
+func (d *productPricesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*Client)
+}
+
+func (d *productPricesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_product_prices"
+}
+
+func (d *productPricesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns the published prices of a product from the Vantage product catalog, optionally filtered by region and purchase option.",
+		Attributes: map[string]schema.Attribute{
+			"product_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The id of the product to return prices for (e.g. `aws-ec2-m5_large`). See the `vantage_products` data source.",
+			},
+			"region": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return prices for this region (e.g. `us-east-1`).",
+			},
+			"purchase_option": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return prices for this purchase option (e.g. `on_demand`, `reserved`, `spot`).",
+			},
+			"prices": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The list of prices returned by the API.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The id of the price.",
+						},
+						"region": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The region the price applies to.",
+						},
+						"unit": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unit the price is charged per (e.g. `hour`).",
+						},
+						"amount": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The price per unit.",
+						},
+						"currency": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The currency of the price (e.g. `USD`).",
+						},
+						"purchase_option": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The purchase option of the price (e.g. `on_demand`). Null if the price does not specify one.",
+						},
+						"details": schema.MapAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Additional provider-specific attributes of the price (e.g. platform or term), as strings.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *productPricesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state productPricesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allPrices, err := fetchAllProductPrices(d.client, state.ProductId.ValueString())
+	if err != nil {
+		if _, ok := err.(*productsv2.GetPricesNotFound); ok {
+			resp.Diagnostics.AddError(
+				"Product Not Found",
+				fmt.Sprintf("No product with id %q was found.", state.ProductId.ValueString()),
+			)
+			return
+		}
+		handleError("Read Product Prices", &resp.Diagnostics, err)
+		return
+	}
+
+	// Region and purchase option are not query parameters on the Get All
+	// Prices endpoint, so they are applied to the fetched results instead.
+	filterByRegion := !state.Region.IsNull() && !state.Region.IsUnknown()
+	filterByPurchaseOption := !state.PurchaseOption.IsNull() && !state.PurchaseOption.IsUnknown()
+
+	state.Prices = make([]productPriceItemModel, 0, len(allPrices))
+	for _, price := range allPrices {
+		details := priceDetails(price)
+		purchaseOption, hasPurchaseOption := details["purchase_option"]
+
+		if filterByRegion && price.Region != state.Region.ValueString() {
+			continue
+		}
+		if filterByPurchaseOption && purchaseOption != state.PurchaseOption.ValueString() {
+			continue
+		}
+
+		detailsValue, diags := types.MapValueFrom(ctx, types.StringType, details)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		item := productPriceItemModel{
+			Id:             types.StringValue(price.ID),
+			Region:         types.StringValue(price.Region),
+			Unit:           types.StringValue(price.Unit),
+			Amount:         types.Float64Value(price.Amount),
+			Currency:       types.StringValue(price.Currency),
+			PurchaseOption: types.StringNull(),
+			Details:        detailsValue,
+		}
+		if hasPurchaseOption {
+			item.PurchaseOption = types.StringValue(purchaseOption)
+		}
+		state.Prices = append(state.Prices, item)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// priceDetails flattens the free-form details object of a price into a map of
+// strings. Non-string values are formatted with fmt.Sprint.
+func priceDetails(price *modelsv2.Price) map[string]string {
+	out := map[string]string{}
+	raw, ok := price.Details.(map[string]interface{})
+	if !ok {
+		return out
+	}
+	for k, v := range raw {
+		if v == nil {
+			continue
+		}
+		if s, ok := v.(string); ok {
+			out[k] = s
+			continue
+		}
+		out[k] = fmt.Sprint(v)
+	}
+	return out
+}
+
+// fetchAllProductPrices returns every price of a product, across all pages
+// of the Get All Prices endpoint.
+func fetchAllProductPrices(client *Client, productID string) ([]*modelsv2.Price, error) {
+	return fetchAllPages(func(limit int32, page *int32) ([]*modelsv2.Price, *modelsv2.Links, error) {
+		params := productsv2.NewGetPricesParams()
+		params.SetProductID(productID)
+		params.SetLimit(&limit)
+		params.SetPage(page)
+		out, err := client.V2.Products.GetPrices(params, client.Auth)
+		if err != nil {
+			return nil, nil, err
+		}
+		return out.Payload.Prices, out.Payload.Links, nil
+	})
+}
@@ -0,0 +1,151 @@
+package vantage
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	productsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/products"
+)
+
+var (
+	_ datasource.DataSource              = (*productsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*productsDataSource)(nil)
+)
+
+func NewProductsDataSource() datasource.DataSource {
+	return &productsDataSource{}
+}
+
+type productItemModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Category   types.String `tfsdk:"category"`
+	ProviderId types.String `tfsdk:"provider_id"`
+	ServiceId  types.String `tfsdk:"service_id"`
+}
+
+type productsDataSourceModel struct {
+	ProviderId types.String       `tfsdk:"provider_id"`
+	ServiceId  types.String       `tfsdk:"service_id"`
+	Name       types.String       `tfsdk:"name"`
+	Products   []productItemModel `tfsdk:"products"`
+}
+
+type productsDataSource struct {
+	client *Client
+}
+
+func (d *productsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*Client)
+}
+
+func (d *productsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_products"
+}
+
+func (d *productsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns products from the Vantage product catalog (e.g. instance types and storage classes), optionally filtered by provider, service and name. Use with `vantage_product_prices` to look up published rates.",
+		Attributes: map[string]schema.Attribute{
+			"provider_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return products for this provider (e.g. `aws`).",
+			},
+			"service_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return products for this service (e.g. `aws-ec2`).",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return products whose name contains this text (case-insensitive).",
+			},
+			"products": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The list of products returned by the API.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The id of the product (e.g. `aws-ec2-m5_large`).",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the product (e.g. `m5.large`).",
+						},
+						"category": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The category of the product (e.g. `compute`).",
+						},
+						"provider_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The id of the provider of the product.",
+						},
+						"service_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The id of the service of the product.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *productsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state productsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allProducts, err := fetchAllProducts(d.client, state.ProviderId.ValueStringPointer(), state.ServiceId.ValueStringPointer())
+	if err != nil {
+		handleError("Read Products", &resp.Diagnostics, err)
+		return
+	}
+
+	// The API has no name query parameter, so the name filter is applied to
+	// the fetched results instead.
+	nameFilter := strings.ToLower(state.Name.ValueString())
+
+	state.Products = make([]productItemModel, 0, len(allProducts))
+	for _, product := range allProducts {
+		if nameFilter != "" && !strings.Contains(strings.ToLower(product.Name), nameFilter) {
+			continue
+		}
+
+		state.Products = append(state.Products, productItemModel{
+			Id:         types.StringValue(product.ID),
+			Name:       types.StringValue(product.Name),
+			Category:   types.StringValue(product.Category),
+			ProviderId: types.StringValue(product.ProviderID),
+			ServiceId:  types.StringValue(product.ServiceID),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// fetchAllProducts returns every product matching the provider and service,
+// across all pages of the Get All Products endpoint.
+func fetchAllProducts(client *Client, providerID, serviceID *string) ([]*modelsv2.Product, error) {
+	return fetchAllPages(func(limit int32, page *int32) ([]*modelsv2.Product, *modelsv2.Links, error) {
+		params := productsv2.NewGetProductsParams()
+		params.SetLimit(&limit)
+		params.SetProviderID(providerID)
+		params.SetServiceID(serviceID)
+		params.SetPage(page)
+		out, err := client.V2.Products.GetProducts(params, client.Auth)
+		if err != nil {
+			return nil, nil, err
+		}
+		return out.Payload.Products, out.Payload.Links, nil
+	})
+}
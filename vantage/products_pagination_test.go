@@ -0,0 +1,115 @@
+package vantage
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+)
+
+// ---------------------------------------------------------------------------
+// fetchAllProducts / fetchAllProductPrices unit tests — driven by a mock HTTP server
+// ---------------------------------------------------------------------------
+
+type productsResponse struct {
+	Products []*modelsv2.Product `json:"products"`
+	Links    *modelsv2.Links     `json:"links,omitempty"`
+}
+
+type pricesResponse struct {
+	Prices []*modelsv2.Price `json:"prices"`
+	Links  *modelsv2.Links   `json:"links,omitempty"`
+}
+
+func TestFetchAllProducts_multiplePagesAndFilters(t *testing.T) {
+	pages := [][]*modelsv2.Product{
+		{{ID: "aws-ec2-m5_large", Name: "m5.large", ProviderID: "aws", ServiceID: "aws-ec2"}},
+		{{ID: "aws-ec2-m5_xlarge", Name: "m5.xlarge", ProviderID: "aws", ServiceID: "aws-ec2"}},
+	}
+	var capturedProvider, capturedService string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/products" {
+			http.NotFound(w, r)
+			return
+		}
+		capturedProvider = r.URL.Query().Get("provider_id")
+		capturedService = r.URL.Query().Get("service_id")
+		pageNum := requestedPage(r)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(productsResponse{Products: pages[pageNum-1], Links: nextLink(r, pageNum, len(pages))})
+	}))
+	defer srv.Close()
+
+	provider := "aws"
+	service := "aws-ec2"
+	got, err := fetchAllProducts(clientForServer(t, srv.URL), &provider, &service)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d products, want 2", len(got))
+	}
+	if got[0].ID != "aws-ec2-m5_large" || got[1].ID != "aws-ec2-m5_xlarge" {
+		t.Errorf("unexpected ids: %v %v", got[0].ID, got[1].ID)
+	}
+	if capturedProvider != provider || capturedService != service {
+		t.Errorf("filters not forwarded: got provider_id=%q service_id=%q", capturedProvider, capturedService)
+	}
+}
+
+func TestFetchAllProductPrices_multiplePages(t *testing.T) {
+	pages := [][]*modelsv2.Price{
+		{{ID: "aws-ec2-m5_large-us_east_1-on_demand", Region: "us-east-1", Amount: 0.096}},
+		{{ID: "aws-ec2-m5_large-eu_west_1-on_demand", Region: "eu-west-1", Amount: 0.107}},
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/products/aws-ec2-m5_large/prices" {
+			http.NotFound(w, r)
+			return
+		}
+		pageNum := requestedPage(r)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(pricesResponse{Prices: pages[pageNum-1], Links: nextLink(r, pageNum, len(pages))})
+	}))
+	defer srv.Close()
+
+	got, err := fetchAllProductPrices(clientForServer(t, srv.URL), "aws-ec2-m5_large")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d prices, want 2", len(got))
+	}
+	if got[1].Region != "eu-west-1" {
+		t.Errorf("unexpected region on second price: %q", got[1].Region)
+	}
+}
+
+func TestPriceDetails(t *testing.T) {
+	price := &modelsv2.Price{
+		Details: map[string]interface{}{
+			"purchase_option": "on_demand",
+			"vcpu":            float64(2),
+			"ignored":         nil,
+		},
+	}
+
+	got := priceDetails(price)
+	if got["purchase_option"] != "on_demand" {
+		t.Errorf("purchase_option: got %q, want %q", got["purchase_option"], "on_demand")
+	}
+	if got["vcpu"] != "2" {
+		t.Errorf("vcpu: got %q, want %q", got["vcpu"], "2")
+	}
+	if _, ok := got["ignored"]; ok {
+		t.Error("nil details values should be omitted")
+	}
+
+	if len(priceDetails(&modelsv2.Price{})) != 0 {
+		t.Error("expected empty map for price without details")
+	}
+}
@@ -216,6 +216,24 @@ func (p *vantageProvider) DataSources(_ context.Context) []func() datasource.Dat
 		NewIntegrationByNameDataSource,
 		NewWorkspaceDataSource,
 		NewFolderDataSource,
+		NewAnomalyAlertsDataSource,
+		NewRecommendationsDataSource,
+		NewTagsDataSource,
+		NewTagValuesDataSource,
+		NewProductsDataSource,
+		NewProductPricesDataSource,
+		NewResourcesDataSource,
+		NewCostReportDataSource,
+		NewSavedFilterDataSource,
+		NewDashboardDataSource,
+		NewSegmentDataSource,
+		NewBudgetDataSource,
+		NewTeamDataSource,
+		NewResourceReportDataSource,
+		NewFolderTreeDataSource,
+		NewBudgetHierarchyDataSource,
+		NewBudgetPerformanceDataSource,
+		NewVirtualTagPreviewDataSource,
 	}
 }
 
@@ -251,5 +269,18 @@ func (p *vantageProvider) Resources(_ context.Context) []func() resource.Resourc
 		NewWorkspaceResource,
 		NewCustomProviderResource,
 		NewCustomProviderCostsUploadResource,
+		NewAnomalyAlertResource,
+		NewAwsIntegrationResource,
+		NewGcpIntegrationResource,
+		NewAzureIntegrationResource,
+		NewKubernetesIntegrationResource,
+		NewDatadogIntegrationResource,
+		NewSnowflakeIntegrationResource,
+		NewMongoDBAtlasIntegrationResource,
+		NewConfluentIntegrationResource,
+		NewTeamMemberResource,
+		NewUserResource,
+		NewAccessGrantsResource,
+		NewVirtualTagConfigValueResource,
 	}
 }
@@ -0,0 +1,395 @@
+package vantage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	recviewsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/recommendation_views"
+	recommendationsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/recommendations"
+)
+
+var (
+	_ datasource.DataSource              = (*recommendationsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*recommendationsDataSource)(nil)
+)
+
+func NewRecommendationsDataSource() datasource.DataSource {
+	return &recommendationsDataSource{}
+}
+
+type recommendationItemModel struct {
+	Token                  types.String `tfsdk:"token"`
+	Category               types.String `tfsdk:"category"`
+	Description            types.String `tfsdk:"description"`
+	Provider               types.String `tfsdk:"provider"`
+	ProviderAccountId      types.String `tfsdk:"provider_account_id"`
+	Service                types.String `tfsdk:"service"`
+	PotentialSavings       types.String `tfsdk:"potential_savings"`
+	ResourcesAffectedCount types.Int64  `tfsdk:"resources_affected_count"`
+	Resources              types.List   `tfsdk:"resources"`
+	Status                 types.String `tfsdk:"status"`
+	WorkspaceToken         types.String `tfsdk:"workspace_token"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+}
+
+type recommendationsDataSourceModel struct {
+	RecommendationViewToken types.String              `tfsdk:"recommendation_view_token"`
+	WorkspaceToken          types.String              `tfsdk:"workspace_token"`
+	ProviderIds             types.List                `tfsdk:"provider_ids"`
+	BillingAccountIds       types.List                `tfsdk:"billing_account_ids"`
+	AccountIds              types.List                `tfsdk:"account_ids"`
+	Regions                 types.List                `tfsdk:"regions"`
+	TagKey                  types.String              `tfsdk:"tag_key"`
+	TagValue                types.String              `tfsdk:"tag_value"`
+	StartDate               types.String              `tfsdk:"start_date"`
+	EndDate                 types.String              `tfsdk:"end_date"`
+	Category                types.String              `tfsdk:"category"`
+	Status                  types.String              `tfsdk:"status"`
+	IncludeResources        types.Bool                `tfsdk:"include_resources"`
+	Recommendations         []recommendationItemModel `tfsdk:"recommendations"`
+}
+
+// recommendationsFilter holds the query parameters accepted by the Get All
+// Recommendations endpoint. They mirror the fields stored on a
+// RecommendationView. Nil or empty fields are omitted from the request.
+type recommendationsFilter struct {
+	WorkspaceToken    *string
+	ProviderIds       []string
+	BillingAccountIds []string
+	AccountIds        []string
+	Regions           []string
+	TagKey            *string
+	TagValue          *string
+	StartDate         *string
+	EndDate           *string
+	Category          *string
+}
+
+type recommendationsDataSource struct {
+	client *Client
+}
+
+func (d *recommendationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*Client)
+}
+
+func (d *recommendationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_recommendations"
+}
+
+func (d *recommendationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	itemAttrs := map[string]schema.Attribute{
+		"token": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The unique token of the recommendation.",
+		},
+		"category": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The category of the recommendation (e.g. `ec2_rightsizing_recommender`).",
+		},
+		"description": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "A description of the recommended change.",
+		},
+		"provider": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The provider the recommendation applies to (e.g. `aws`).",
+		},
+		"provider_account_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The provider account id the recommendation applies to.",
+		},
+		"service": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The service the recommendation applies to.",
+		},
+		"potential_savings": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The estimated monthly savings if the recommendation is applied.",
+		},
+		"resources_affected_count": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The number of resources affected by the recommendation.",
+		},
+		"resources": schema.ListAttribute{
+			Computed:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "The provider ids of the resources affected by the recommendation. Only set when `include_resources` is true.",
+		},
+		"status": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The status of the recommendation (e.g. `open`, `resolved`, `dismissed`).",
+		},
+		"workspace_token": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The token of the Workspace the recommendation belongs to.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The date and time (UTC, ISO 8601) when the recommendation was created.",
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns recommendations scoped either by an existing `vantage_recommendation_view` or by the same filter fields a recommendation view accepts.",
+		Attributes: map[string]schema.Attribute{
+			"recommendation_view_token": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Token of a Recommendation View whose filters should be applied. Cannot be combined with the individual filter arguments.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("workspace_token"),
+						path.MatchRoot("provider_ids"),
+						path.MatchRoot("billing_account_ids"),
+						path.MatchRoot("account_ids"),
+						path.MatchRoot("regions"),
+						path.MatchRoot("tag_key"),
+						path.MatchRoot("tag_value"),
+						path.MatchRoot("start_date"),
+						path.MatchRoot("end_date"),
+					),
+				},
+			},
+			"workspace_token": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return recommendations in this Workspace.",
+			},
+			"provider_ids": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Filter by one or more providers.",
+			},
+			"billing_account_ids": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Filter by billing account identifiers.",
+			},
+			"account_ids": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Filter by cloud account identifiers.",
+			},
+			"regions": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Filter by region slugs (e.g. us-east-1, eastus, asia-east1).",
+			},
+			"tag_key": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Filter by tag key (must be used with tag_value).",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("tag_value")),
+				},
+			},
+			"tag_value": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Filter by tag value (requires tag_key).",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("tag_key")),
+				},
+			},
+			"start_date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Filter recommendations created on/after this YYYY-MM-DD date.",
+			},
+			"end_date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Filter recommendations created on/before this YYYY-MM-DD date.",
+			},
+			"category": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return recommendations in this category. Can be combined with `recommendation_view_token`.",
+			},
+			"status": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return recommendations with this status (e.g. `open`). Can be combined with `recommendation_view_token`.",
+			},
+			"include_resources": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Also fetch the resources affected by each recommendation into `resources`. This makes a request per recommendation, so prefer narrowing the results with the other arguments first. Defaults to `false`.",
+			},
+			"recommendations": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The list of recommendations returned by the API.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: itemAttrs,
+				},
+			},
+		},
+	}
+}
+
+func (d *recommendationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state recommendationsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var filter recommendationsFilter
+	if !state.RecommendationViewToken.IsNull() && !state.RecommendationViewToken.IsUnknown() {
+		params := recviewsv2.NewGetRecommendationViewParams().WithRecommendationViewToken(state.RecommendationViewToken.ValueString())
+		out, err := d.client.V2.RecommendationViews.GetRecommendationView(params, d.client.Auth)
+		if err != nil {
+			if _, ok := err.(*recviewsv2.GetRecommendationViewNotFound); ok {
+				resp.Diagnostics.AddError(
+					"Recommendation View Not Found",
+					fmt.Sprintf("No recommendation view with token %q was found.", state.RecommendationViewToken.ValueString()),
+				)
+				return
+			}
+			handleError("Read Recommendation View", &resp.Diagnostics, err)
+			return
+		}
+		filter = recommendationsFilterFromView(out.Payload)
+	} else {
+		filter = recommendationsFilter{
+			WorkspaceToken: state.WorkspaceToken.ValueStringPointer(),
+			TagKey:         state.TagKey.ValueStringPointer(),
+			TagValue:       state.TagValue.ValueStringPointer(),
+			StartDate:      state.StartDate.ValueStringPointer(),
+			EndDate:        state.EndDate.ValueStringPointer(),
+		}
+		for _, l := range []struct {
+			src types.List
+			dst *[]string
+		}{
+			{state.ProviderIds, &filter.ProviderIds},
+			{state.BillingAccountIds, &filter.BillingAccountIds},
+			{state.AccountIds, &filter.AccountIds},
+			{state.Regions, &filter.Regions},
+		} {
+			if l.src.IsNull() || l.src.IsUnknown() {
+				continue
+			}
+			resp.Diagnostics.Append(l.src.ElementsAs(ctx, l.dst, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+	filter.Category = state.Category.ValueStringPointer()
+
+	allRecommendations, err := fetchAllRecommendations(d.client, filter)
+	if err != nil {
+		handleError("Read Recommendations", &resp.Diagnostics, err)
+		return
+	}
+
+	filterByStatus := !state.Status.IsNull() && !state.Status.IsUnknown()
+
+	state.Recommendations = make([]recommendationItemModel, 0, len(allRecommendations))
+	for _, rec := range allRecommendations {
+		if filterByStatus && rec.Status != state.Status.ValueString() {
+			continue
+		}
+
+		resources := types.ListNull(types.StringType)
+		if state.IncludeResources.ValueBool() {
+			ids, err := fetchRecommendationResources(d.client, rec.Token)
+			if err != nil {
+				handleError("Read Recommendation Resources", &resp.Diagnostics, err)
+				return
+			}
+			list, diags := types.ListValueFrom(ctx, types.StringType, ids)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			resources = list
+		}
+
+		state.Recommendations = append(state.Recommendations, recommendationItemModel{
+			Token:                  types.StringValue(rec.Token),
+			Category:               types.StringValue(rec.Category),
+			Description:            types.StringValue(rec.Description),
+			Provider:               types.StringValue(rec.Provider),
+			ProviderAccountId:      types.StringValue(rec.ProviderAccountID),
+			Service:                types.StringValue(rec.Service),
+			PotentialSavings:       types.StringValue(rec.PotentialSavings),
+			ResourcesAffectedCount: types.Int64Value(int64(rec.ResourcesAffectedCount)),
+			Resources:              resources,
+			Status:                 types.StringValue(rec.Status),
+			WorkspaceToken:         types.StringValue(rec.WorkspaceToken),
+			CreatedAt:              types.StringValue(rec.CreatedAt),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// recommendationsFilterFromView copies the filter fields stored on a
+// RecommendationView into a recommendationsFilter.
+func recommendationsFilterFromView(view *modelsv2.RecommendationView) recommendationsFilter {
+	return recommendationsFilter{
+		WorkspaceToken:    view.WorkspaceToken,
+		ProviderIds:       view.ProviderIds,
+		BillingAccountIds: view.BillingAccountIds,
+		AccountIds:        view.AccountIds,
+		Regions:           view.Regions,
+		TagKey:            view.TagKey,
+		TagValue:          view.TagValue,
+		StartDate:         view.StartDate,
+		EndDate:           view.EndDate,
+	}
+}
+
+// fetchAllRecommendations returns every recommendation matching filter,
+// across all pages of the Get All Recommendations endpoint.
+func fetchAllRecommendations(client *Client, filter recommendationsFilter) ([]*modelsv2.Recommendation, error) {
+	return fetchAllPages(func(limit int32, page *int32) ([]*modelsv2.Recommendation, *modelsv2.Links, error) {
+		params := recommendationsv2.NewGetRecommendationsParams()
+		params.SetLimit(&limit)
+		params.SetWorkspaceToken(filter.WorkspaceToken)
+		params.SetProviderIds(filter.ProviderIds)
+		params.SetBillingAccountIds(filter.BillingAccountIds)
+		params.SetAccountIds(filter.AccountIds)
+		params.SetRegions(filter.Regions)
+		params.SetTagKey(filter.TagKey)
+		params.SetTagValue(filter.TagValue)
+		params.SetStartDate(filter.StartDate)
+		params.SetEndDate(filter.EndDate)
+		params.SetCategory(filter.Category)
+		params.SetPage(page)
+		out, err := client.V2.Recommendations.GetRecommendations(params, client.Auth)
+		if err != nil {
+			return nil, nil, err
+		}
+		return out.Payload.Recommendations, out.Payload.Links, nil
+	})
+}
+
+// fetchRecommendationResources returns the provider ids of every resource
+// affected by the recommendation.
+func fetchRecommendationResources(client *Client, token string) ([]string, error) {
+	resources, err := fetchAllPages(func(limit int32, page *int32) ([]*modelsv2.RecommendationProviderResource, *modelsv2.Links, error) {
+		params := recommendationsv2.NewGetRecommendationResourcesParams()
+		params.SetRecommendationToken(token)
+		params.SetLimit(&limit)
+		params.SetPage(page)
+		out, err := client.V2.Recommendations.GetRecommendationResources(params, client.Auth)
+		if err != nil {
+			return nil, nil, err
+		}
+		return out.Payload.Resources, out.Payload.Links, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(resources))
+	for _, r := range resources {
+		ids = append(ids, r.ResourceID)
+	}
+	return ids, nil
+}
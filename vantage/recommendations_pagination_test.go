@@ -0,0 +1,216 @@
+package vantage
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+)
+
+// ---------------------------------------------------------------------------
+// fetchAllRecommendations unit tests — driven by a mock HTTP server
+// ---------------------------------------------------------------------------
+
+func mockRecommendation(token, category string) *modelsv2.Recommendation {
+	return &modelsv2.Recommendation{
+		Token:                  token,
+		Category:               category,
+		Description:            "Rightsize instance",
+		Provider:               "aws",
+		ProviderAccountID:      "123456789012",
+		Service:                "Amazon Elastic Compute Cloud - Compute",
+		PotentialSavings:       "42.00",
+		ResourcesAffectedCount: 1,
+		Status:                 "open",
+		WorkspaceToken:         "wrkspc_1",
+		CreatedAt:              "2024-01-01T00:00:00Z",
+	}
+}
+
+type recommendationsResponse struct {
+	Recommendations []*modelsv2.Recommendation `json:"recommendations"`
+	Links           *modelsv2.Links            `json:"links,omitempty"`
+}
+
+func newMockRecommendationsServer(t *testing.T, pages [][]*modelsv2.Recommendation) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/recommendations" {
+			http.NotFound(w, r)
+			return
+		}
+
+		pageStr := r.URL.Query().Get("page")
+		pageNum := 1
+		if pageStr != "" {
+			fmt.Sscanf(pageStr, "%d", &pageNum)
+		}
+
+		idx := pageNum - 1
+		if idx < 0 || idx >= len(pages) {
+			http.Error(w, "page out of range", http.StatusBadRequest)
+			return
+		}
+
+		var links *modelsv2.Links
+		if pageNum < len(pages) {
+			next := fmt.Sprintf("http://%s/v2/recommendations?limit=1000&page=%d", r.Host, pageNum+1)
+			links = &modelsv2.Links{Next: &next}
+		}
+
+		resp := recommendationsResponse{
+			Recommendations: pages[idx],
+			Links:           links,
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	return srv
+}
+
+func TestFetchAllRecommendations_multiplePages(t *testing.T) {
+	page1 := []*modelsv2.Recommendation{mockRecommendation("rcmmndtn_1", "ec2_rightsizing_recommender")}
+	page2 := []*modelsv2.Recommendation{mockRecommendation("rcmmndtn_2", "ebs_volume_type_recommender")}
+
+	srv := newMockRecommendationsServer(t, [][]*modelsv2.Recommendation{page1, page2})
+	defer srv.Close()
+
+	got, err := fetchAllRecommendations(clientForServer(t, srv.URL), recommendationsFilter{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d recommendations, want 2", len(got))
+	}
+	if got[0].Token != "rcmmndtn_1" || got[1].Token != "rcmmndtn_2" {
+		t.Errorf("unexpected tokens: %v %v", got[0].Token, got[1].Token)
+	}
+}
+
+func TestFetchAllRecommendations_forwardsFilters(t *testing.T) {
+	var captured map[string]string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		captured = map[string]string{
+			"workspace_token": q.Get("workspace_token"),
+			"tag_key":         q.Get("tag_key"),
+			"tag_value":       q.Get("tag_value"),
+			"category":        q.Get("category"),
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(recommendationsResponse{Recommendations: []*modelsv2.Recommendation{}})
+	}))
+	defer srv.Close()
+
+	workspace := "wrkspc_1"
+	tagKey := "team"
+	tagValue := "platform"
+	category := "ec2_rightsizing_recommender"
+	_, err := fetchAllRecommendations(clientForServer(t, srv.URL), recommendationsFilter{
+		WorkspaceToken: &workspace,
+		TagKey:         &tagKey,
+		TagValue:       &tagValue,
+		Category:       &category,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]string{
+		"workspace_token": workspace,
+		"tag_key":         tagKey,
+		"tag_value":       tagValue,
+		"category":        category,
+	}
+	for k, v := range want {
+		if captured[k] != v {
+			t.Errorf("query parameter %s not forwarded: got %q, want %q", k, captured[k], v)
+		}
+	}
+}
+
+func TestRecommendationsFilterFromView(t *testing.T) {
+	workspace := "wrkspc_1"
+	tagKey := "team"
+	view := &modelsv2.RecommendationView{
+		WorkspaceToken: &workspace,
+		ProviderIds:    []string{"aws", "gcp"},
+		Regions:        []string{"us-east-1"},
+		TagKey:         &tagKey,
+	}
+
+	got := recommendationsFilterFromView(view)
+	if got.WorkspaceToken == nil || *got.WorkspaceToken != workspace {
+		t.Errorf("WorkspaceToken: got %v, want %q", got.WorkspaceToken, workspace)
+	}
+	if len(got.ProviderIds) != 2 || got.ProviderIds[1] != "gcp" {
+		t.Errorf("ProviderIds: got %v", got.ProviderIds)
+	}
+	if len(got.Regions) != 1 || got.Regions[0] != "us-east-1" {
+		t.Errorf("Regions: got %v", got.Regions)
+	}
+	if got.TagKey == nil || *got.TagKey != tagKey {
+		t.Errorf("TagKey: got %v, want %q", got.TagKey, tagKey)
+	}
+	if got.Category != nil {
+		t.Errorf("Category should not be copied from the view, got %q", *got.Category)
+	}
+}
+
+func TestFetchAllRecommendations_apiError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	_, err := fetchAllRecommendations(clientForServer(t, srv.URL), recommendationsFilter{})
+	if err == nil {
+		t.Fatal("expected error from API, got nil")
+	}
+}
+
+func TestFetchRecommendationResources(t *testing.T) {
+	pages := [][]*modelsv2.RecommendationProviderResource{
+		{{ResourceID: "i-1"}},
+		{{ResourceID: "i-2"}, {ResourceID: "i-3"}},
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/recommendations/rcmmndtn_1/resources" {
+			http.NotFound(w, r)
+			return
+		}
+
+		pageNum := 1
+		if pageStr := r.URL.Query().Get("page"); pageStr != "" {
+			fmt.Sscanf(pageStr, "%d", &pageNum)
+		}
+		if pageNum < 1 || pageNum > len(pages) {
+			http.Error(w, "page out of range", http.StatusBadRequest)
+			return
+		}
+
+		var links *modelsv2.Links
+		if pageNum < len(pages) {
+			next := fmt.Sprintf("http://%s%s?limit=1000&page=%d", r.Host, r.URL.Path, pageNum+1)
+			links = &modelsv2.Links{Next: &next}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(struct {
+			Resources []*modelsv2.RecommendationProviderResource `json:"resources"`
+			Links     *modelsv2.Links                            `json:"links,omitempty"`
+		}{pages[pageNum-1], links})
+	}))
+	defer srv.Close()
+
+	got, err := fetchRecommendationResources(clientForServer(t, srv.URL), "rcmmndtn_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 3 || got[0] != "i-1" || got[1] != "i-2" || got[2] != "i-3" {
+		t.Errorf("got resources %v, want [i-1 i-2 i-3]", got)
+	}
+}
@@ -14,7 +14,7 @@ type resourceReportModel resource_resource_report.ResourceReportModel
 func (r *resourceReportModel) applyPayload(ctx context.Context, payload *modelsv2.ResourceReport, isDataSource bool) diag.Diagnostics {
 	r.CreatedAt = types.StringValue(payload.CreatedAt)
 	r.CreatedByToken = types.StringPointerValue(payload.CreatedByToken)
-	r.Filter = types.StringPointerValue(payload.Filter)
+	r.Filter = equivalentFilter(r.Filter, types.StringPointerValue(payload.Filter))
 	r.FolderToken = types.StringPointerValue(payload.FolderToken)
 	r.Title = types.StringValue(payload.Title)
 	r.Token = types.StringValue(payload.Token)
@@ -0,0 +1,266 @@
+package vantage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	resourcesv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/resources"
+)
+
+var (
+	_ datasource.DataSource                     = (*resourcesDataSource)(nil)
+	_ datasource.DataSourceWithConfigure        = (*resourcesDataSource)(nil)
+	_ datasource.DataSourceWithConfigValidators = (*resourcesDataSource)(nil)
+)
+
+func NewResourcesDataSource() datasource.DataSource {
+	return &resourcesDataSource{}
+}
+
+var resourceAccruedCostAttrTypes = map[string]attr.Type{
+	"category": types.StringType,
+	"amount":   types.StringType,
+}
+
+type resourceItemModel struct {
+	Token            types.String `tfsdk:"token"`
+	ProviderId       types.String `tfsdk:"provider_id"`
+	Type             types.String `tfsdk:"type"`
+	Label            types.String `tfsdk:"label"`
+	Provider         types.String `tfsdk:"provider"`
+	AccountId        types.String `tfsdk:"account_id"`
+	BillingAccountId types.String `tfsdk:"billing_account_id"`
+	Region           types.String `tfsdk:"region"`
+	Metadata         types.String `tfsdk:"metadata"`
+	AccruedCosts     types.List   `tfsdk:"accrued_costs"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+}
+
+type resourcesDataSourceModel struct {
+	ResourceReportToken types.String        `tfsdk:"resource_report_token"`
+	Filter              types.String        `tfsdk:"filter"`
+	WorkspaceToken      types.String        `tfsdk:"workspace_token"`
+	IncludeCosts        types.Bool          `tfsdk:"include_costs"`
+	Resources           []resourceItemModel `tfsdk:"resources"`
+}
+
+// resourcesFilter holds the query parameters accepted by the Get All
+// Resources endpoint. Nil fields are omitted from the request.
+type resourcesFilter struct {
+	ResourceReportToken *string
+	Filter              *string
+	WorkspaceToken      *string
+	IncludeCost         *bool
+}
+
+type resourcesDataSource struct {
+	client *Client
+}
+
+func (d *resourcesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*Client)
+}
+
+func (d *resourcesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resources"
+}
+
+func (d *resourcesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns the resources in the Vantage resource inventory that match a Resource Report or a VQL filter.",
+		Attributes: map[string]schema.Attribute{
+			"resource_report_token": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Return the resources matched by this Resource Report. Exactly one of `resource_report_token` or `filter` must be set.",
+			},
+			"filter": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "VQL filter to match resources with (e.g. `resources.provider = 'aws' AND resources.type = 'aws_instance'`). See https://docs.vantage.sh/vql_resource_report. Exactly one of `resource_report_token` or `filter` must be set.",
+			},
+			"workspace_token": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The token of the Workspace to query when using `filter`. Required if the API token has access to more than one Workspace.",
+			},
+			"include_costs": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to include the accrued costs of each resource. Defaults to `false`, which leaves `accrued_costs` empty.",
+			},
+			"resources": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The list of resources returned by the API.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"token": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique token of the resource.",
+						},
+						"provider_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The provider's identifier of the resource (e.g. an ARN or instance id).",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The VQL resource type (e.g. `aws_instance`).",
+						},
+						"label": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The display label of the resource. Null if the resource has no label.",
+						},
+						"provider": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The provider of the resource (e.g. `aws`).",
+						},
+						"account_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The provider account id the resource belongs to.",
+						},
+						"billing_account_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The provider billing account id the resource belongs to.",
+						},
+						"region": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The region of the resource. Null for global resources.",
+						},
+						"metadata": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The resource's provider-specific metadata as a JSON string. Decode with `jsondecode`.",
+						},
+						"accrued_costs": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The accrued costs of the resource by cost category. Only populated when `include_costs` is `true`.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"category": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The cost category.",
+									},
+									"amount": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The accrued amount for the category.",
+									},
+								},
+							},
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The date and time (UTC, ISO 8601) when the resource was first seen.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *resourcesDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("resource_report_token"),
+			path.MatchRoot("filter"),
+		),
+	}
+}
+
+func (d *resourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state resourcesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := resourcesFilter{
+		ResourceReportToken: state.ResourceReportToken.ValueStringPointer(),
+		Filter:              state.Filter.ValueStringPointer(),
+		WorkspaceToken:      state.WorkspaceToken.ValueStringPointer(),
+		IncludeCost:         state.IncludeCosts.ValueBoolPointer(),
+	}
+
+	allResources, err := fetchAllResources(d.client, filter)
+	if err != nil {
+		if e, ok := err.(*resourcesv2.GetResourcesBadRequest); ok {
+			handleBadRequest("Read Resources", &resp.Diagnostics, e.GetPayload())
+			return
+		}
+		handleError("Read Resources", &resp.Diagnostics, err)
+		return
+	}
+
+	accruedCostType := types.ObjectType{AttrTypes: resourceAccruedCostAttrTypes}
+
+	state.Resources = make([]resourceItemModel, 0, len(allResources))
+	for _, r := range allResources {
+		metadata, err := json.Marshal(r.Metadata)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Resources",
+				fmt.Sprintf("Could not encode metadata of resource %q: %s", r.Token, err),
+			)
+			return
+		}
+
+		costs := make([]attr.Value, 0, len(r.Costs))
+		for _, c := range r.Costs {
+			obj, diags := types.ObjectValue(resourceAccruedCostAttrTypes, map[string]attr.Value{
+				"category": types.StringValue(c.Category),
+				"amount":   types.StringValue(c.Amount),
+			})
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			costs = append(costs, obj)
+		}
+		accruedCosts, diags := types.ListValue(accruedCostType, costs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.Resources = append(state.Resources, resourceItemModel{
+			Token:            types.StringValue(r.Token),
+			ProviderId:       types.StringValue(r.UUID),
+			Type:             types.StringValue(r.Type),
+			Label:            types.StringPointerValue(r.Label),
+			Provider:         types.StringValue(r.Provider),
+			AccountId:        types.StringValue(r.AccountID),
+			BillingAccountId: types.StringValue(r.BillingAccountID),
+			Region:           types.StringPointerValue(r.Region),
+			Metadata:         types.StringValue(string(metadata)),
+			AccruedCosts:     accruedCosts,
+			CreatedAt:        types.StringValue(r.CreatedAt),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// fetchAllResources returns every resource matching filter, across all
+// pages of the Get All Resources endpoint.
+func fetchAllResources(client *Client, filter resourcesFilter) ([]*modelsv2.Resource, error) {
+	return fetchAllPages(func(limit int32, page *int32) ([]*modelsv2.Resource, *modelsv2.Links, error) {
+		params := resourcesv2.NewGetResourcesParams()
+		params.SetLimit(&limit)
+		params.SetResourceReportToken(filter.ResourceReportToken)
+		params.SetFilter(filter.Filter)
+		params.SetWorkspaceToken(filter.WorkspaceToken)
+		params.SetIncludeCost(filter.IncludeCost)
+		params.SetPage(page)
+		out, err := client.V2.Resources.GetResources(params, client.Auth)
+		if err != nil {
+			return nil, nil, err
+		}
+		return out.Payload.Resources, out.Payload.Links, nil
+	})
+}
@@ -0,0 +1,107 @@
+package vantage
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+)
+
+// ---------------------------------------------------------------------------
+// fetchAllResources unit tests — driven by a mock HTTP server
+// ---------------------------------------------------------------------------
+
+type resourcesResponse struct {
+	Resources []*modelsv2.Resource `json:"resources"`
+	Links     *modelsv2.Links      `json:"links,omitempty"`
+}
+
+func mockResource(token, uuid string) *modelsv2.Resource {
+	return &modelsv2.Resource{
+		Token:     token,
+		UUID:      uuid,
+		Type:      "aws_instance",
+		Provider:  "aws",
+		AccountID: "123456789012",
+		CreatedAt: "2024-01-01T00:00:00Z",
+	}
+}
+
+func TestFetchAllResources_multiplePages(t *testing.T) {
+	pages := [][]*modelsv2.Resource{
+		{mockResource("prvdr_rsrc_1", "i-0001")},
+		{mockResource("prvdr_rsrc_2", "i-0002"), mockResource("prvdr_rsrc_3", "i-0003")},
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/resources" {
+			http.NotFound(w, r)
+			return
+		}
+		pageNum := requestedPage(r)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resourcesResponse{Resources: pages[pageNum-1], Links: nextLink(r, pageNum, len(pages))})
+	}))
+	defer srv.Close()
+
+	got, err := fetchAllResources(clientForServer(t, srv.URL), resourcesFilter{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"i-0001", "i-0002", "i-0003"}
+	if len(got) != len(want) {
+		t.Fatalf("got %d resources, want %d", len(got), len(want))
+	}
+	for i, id := range want {
+		if got[i].UUID != id {
+			t.Errorf("index %d: got uuid %q, want %q", i, got[i].UUID, id)
+		}
+	}
+}
+
+func TestFetchAllResources_forwardsFilters(t *testing.T) {
+	var capturedReport, capturedFilter, capturedIncludeCost string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		capturedReport = q.Get("resource_report_token")
+		capturedFilter = q.Get("filter")
+		capturedIncludeCost = q.Get("include_cost")
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resourcesResponse{Resources: []*modelsv2.Resource{}})
+	}))
+	defer srv.Close()
+
+	filter := "resources.provider = 'aws'"
+	includeCost := true
+	_, err := fetchAllResources(clientForServer(t, srv.URL), resourcesFilter{
+		Filter:      &filter,
+		IncludeCost: &includeCost,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if capturedReport != "" {
+		t.Errorf("resource_report_token should be omitted, got %q", capturedReport)
+	}
+	if capturedFilter != filter {
+		t.Errorf("filter not forwarded: got %q, want %q", capturedFilter, filter)
+	}
+	if capturedIncludeCost != "true" {
+		t.Errorf("include_cost not forwarded: got %q, want %q", capturedIncludeCost, "true")
+	}
+}
+
+func TestFetchAllResources_apiError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	_, err := fetchAllResources(clientForServer(t, srv.URL), resourcesFilter{})
+	if err == nil {
+		t.Fatal("expected error from API, got nil")
+	}
+}
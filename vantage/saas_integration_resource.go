@@ -0,0 +1,290 @@
+package vantage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vantage-sh/terraform-provider-vantage/vantage/planmodifiers"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	integrationsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/integrations"
+)
+
+var (
+	_ resource.Resource                = (*saasIntegrationResource)(nil)
+	_ resource.ResourceWithConfigure   = (*saasIntegrationResource)(nil)
+	_ resource.ResourceWithImportState = (*saasIntegrationResource)(nil)
+)
+
+// saasIntegrationField describes one credential or connection attribute of a
+// SaaS integration. Values are only sent on create; the API never returns
+// them, so they are preserved from state on read.
+type saasIntegrationField struct {
+	Name        string
+	Description string
+	Optional    bool
+	// Sensitive fields are secrets. Changing one replaces the integration,
+	// since the API has no endpoint to rotate credentials in place.
+	// Non-sensitive fields are immutable after create instead.
+	Sensitive bool
+}
+
+// saasIntegrationSpec describes a SaaS integration resource. Adding a provider
+// only requires a spec and a create function; reading, workspace assignment,
+// import and deletion go through the generic integrations endpoints.
+type saasIntegrationSpec struct {
+	// TypeName is appended to the provider type name, e.g. "datadog_integration".
+	TypeName string
+	// DisplayName is used in descriptions and diagnostics, e.g. "Datadog".
+	DisplayName string
+	Description string
+	Fields      []saasIntegrationField
+	// Create creates the integration from the configured field values. Values
+	// of unset optional fields are nil.
+	Create func(client *Client, values map[string]*string) (*modelsv2.Integration, error)
+	// BadRequest returns the payload of err when it is the bad request
+	// response of the Create endpoint, and nil otherwise.
+	BadRequest func(err error) *modelsv2.Errors
+}
+
+// saasIntegrationResource is the shared implementation behind every SaaS
+// integration resource. Attributes common to all integrations are read and
+// written by path, so the provider-specific fields never need a model struct.
+type saasIntegrationResource struct {
+	spec   saasIntegrationSpec
+	client *Client
+}
+
+func newSaasIntegrationResource(spec saasIntegrationSpec) resource.Resource {
+	return &saasIntegrationResource{spec: spec}
+}
+
+func (r *saasIntegrationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *saasIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.spec.TypeName
+}
+
+func (r *saasIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attrs := map[string]schema.Attribute{
+		"workspaces": schema.SetAttribute{
+			Optional:            true,
+			Computed:            true,
+			ElementType:         types.StringType,
+			PlanModifiers:       []planmodifier.Set{planmodifiers.UseStateWhenEmpty()},
+			MarkdownDescription: "Workspace tokens to associate with the integration. Can be updated in-place. Note: the Vantage API requires at least one token — workspace associations cannot be fully removed once set.",
+		},
+		"token": schema.StringAttribute{
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			MarkdownDescription: fmt.Sprintf("Unique token of the %s integration. Use it as the `integration_token` of a `vantage_business_metric`.", r.spec.DisplayName),
+		},
+		"id": schema.StringAttribute{
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			MarkdownDescription: "Same as token.",
+		},
+		"status": schema.StringAttribute{
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			MarkdownDescription: "The status of the integration. This is system-managed and cannot be configured.",
+		},
+	}
+
+	for _, f := range r.spec.Fields {
+		attr := schema.StringAttribute{
+			Required:  !f.Optional,
+			Optional:  f.Optional,
+			Sensitive: f.Sensitive,
+		}
+		if f.Sensitive {
+			attr.MarkdownDescription = f.Description + " Not returned by the API. Changing it replaces the integration."
+			attr.PlanModifiers = []planmodifier.String{saasCredentialRequiresReplace(), saasFieldSetAfterImport{}}
+		} else {
+			attr.MarkdownDescription = f.Description + " Cannot be changed after creation."
+			attr.PlanModifiers = []planmodifier.String{planmodifiers.ImmutableAfterCreate(f.Name), saasFieldSetAfterImport{}}
+		}
+		attrs[f.Name] = attr
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes:          attrs,
+		MarkdownDescription: r.spec.Description,
+	}
+}
+
+// saasCredentialRequiresReplace replaces the integration when a secret
+// changes. A secret first configured after import (null in state) is accepted
+// without replacement.
+func saasCredentialRequiresReplace() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		"Changing this credential replaces the integration.",
+		"Changing this credential replaces the integration.",
+	)
+}
+
+// saasFieldSetAfterImport warns when a field is first configured on an
+// imported integration. The API never returns the fields and can't change
+// them, so the value is only recorded in state; the integration keeps the
+// values it was created with.
+type saasFieldSetAfterImport struct{}
+
+func (m saasFieldSetAfterImport) Description(_ context.Context) string {
+	return "Warns that a value first configured after import is not sent to the API."
+}
+
+func (m saasFieldSetAfterImport) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m saasFieldSetAfterImport) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || !req.StateValue.IsNull() || req.ConfigValue.IsNull() {
+		return
+	}
+	resp.Diagnostics.AddAttributeWarning(
+		req.Path,
+		"Value Not Sent to the Integration",
+		fmt.Sprintf("%s is not set in state, as after an import. The API cannot change it on an existing integration, so the configured value is only recorded in state and the integration keeps the value it was created with. "+
+			"To apply it, replace the integration, e.g. with terraform apply -replace.", req.Path),
+	)
+}
+
+func (r *saasIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("token"), req, resp)
+}
+
+func (r *saasIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	values := make(map[string]*string, len(r.spec.Fields))
+	for _, f := range r.spec.Fields {
+		var v types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(f.Name), &v)...)
+		values[f.Name] = v.ValueStringPointer()
+	}
+	var workspaces types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("workspaces"), &workspaces)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	action := fmt.Sprintf("Create %s Integration", r.spec.DisplayName)
+	integration, err := r.spec.Create(r.client, values)
+	if err != nil {
+		if payload := r.spec.BadRequest(err); payload != nil {
+			handleBadRequest(action, &resp.Diagnostics, payload)
+			return
+		}
+		handleError(action, &resp.Diagnostics, err)
+		return
+	}
+
+	// Associate workspaces immediately after creation if specified;
+	// otherwise resolve to an empty set so the value is always known after apply.
+	if !workspaces.IsNull() && !workspaces.IsUnknown() {
+		workspaces = applyIntegrationWorkspaces(ctx, r.client, fmt.Sprintf("Update %s Integration Workspaces", r.spec.DisplayName), integration.Token, workspaces, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		workspaces, _ = types.SetValueFrom(ctx, types.StringType, []string{})
+	}
+
+	// Start from the plan so the configured credentials are stored as-is.
+	resp.State.Raw = req.Plan.Raw
+	r.setComputed(ctx, &resp.State, integration.Token, integration.Status, workspaces, &resp.Diagnostics)
+}
+
+func (r *saasIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var token types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("token"), &token)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := integrationsv2.NewGetIntegrationParams()
+	params.SetIntegrationToken(token.ValueString())
+
+	out, err := r.client.V2.Integrations.GetIntegration(params, r.client.Auth)
+	if err != nil {
+		if _, ok := err.(*integrationsv2.GetIntegrationNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		handleError(fmt.Sprintf("Read %s Integration", r.spec.DisplayName), &resp.Diagnostics, err)
+		return
+	}
+
+	workspaces, diags := types.SetValueFrom(ctx, types.StringType, out.Payload.WorkspaceTokens)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// resp.State starts as a copy of the prior state, so the provider-specific
+	// fields, which the API never returns, are preserved.
+	r.setComputed(ctx, &resp.State, out.Payload.Token, out.Payload.Status, workspaces, &resp.Diagnostics)
+}
+
+func (r *saasIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Provider-specific fields are either immutable or force replacement, so
+	// only workspace changes reach Update, along with fields first configured
+	// after import, which are recorded in state with a warning at plan time
+	// (see saasFieldSetAfterImport).
+	var token, status types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("token"), &token)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("status"), &status)...)
+	var workspaces types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("workspaces"), &workspaces)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaces = applyIntegrationWorkspaces(ctx, r.client, fmt.Sprintf("Update %s Integration Workspaces", r.spec.DisplayName), token.ValueString(), workspaces, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.Raw = req.Plan.Raw
+	r.setComputed(ctx, &resp.State, token.ValueString(), status.ValueString(), workspaces, &resp.Diagnostics)
+}
+
+func (r *saasIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var token types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("token"), &token)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := integrationsv2.NewDeleteIntegrationParams()
+	params.SetIntegrationToken(token.ValueString())
+
+	_, err := r.client.V2.Integrations.DeleteIntegration(params, r.client.Auth)
+	if err != nil {
+		if _, ok := err.(*integrationsv2.DeleteIntegrationNotFound); ok {
+			return
+		}
+		handleError(fmt.Sprintf("Delete %s Integration", r.spec.DisplayName), &resp.Diagnostics, err)
+	}
+}
+
+// setComputed writes the attributes shared by every SaaS integration.
+func (r *saasIntegrationResource) setComputed(ctx context.Context, state *tfsdk.State, token, status string, workspaces types.Set, diags *diag.Diagnostics) {
+	diags.Append(state.SetAttribute(ctx, path.Root("token"), types.StringValue(token))...)
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), types.StringValue(token))...)
+	diags.Append(state.SetAttribute(ctx, path.Root("status"), types.StringValue(status))...)
+	diags.Append(state.SetAttribute(ctx, path.Root("workspaces"), workspaces)...)
+}
@@ -0,0 +1,97 @@
+package vantage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSaasIntegrationResources_schema(t *testing.T) {
+	ctx := context.Background()
+
+	for _, newResource := range []func() resource.Resource{
+		NewDatadogIntegrationResource,
+		NewSnowflakeIntegrationResource,
+		NewMongoDBAtlasIntegrationResource,
+		NewConfluentIntegrationResource,
+	} {
+		r := newResource().(*saasIntegrationResource)
+		t.Run(r.spec.TypeName, func(t *testing.T) {
+			var resp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+				t.Fatalf("invalid schema: %v", diags)
+			}
+
+			for _, name := range []string{"workspaces", "token", "id", "status"} {
+				if _, ok := resp.Schema.Attributes[name]; !ok {
+					t.Errorf("missing common attribute %q", name)
+				}
+			}
+
+			secrets := 0
+			for _, f := range r.spec.Fields {
+				attr, ok := resp.Schema.Attributes[f.Name].(schema.StringAttribute)
+				if !ok {
+					t.Fatalf("attribute %q is not a string attribute", f.Name)
+				}
+				if attr.Sensitive != f.Sensitive {
+					t.Errorf("attribute %q: sensitive = %v, want %v", f.Name, attr.Sensitive, f.Sensitive)
+				}
+				if attr.Required == f.Optional {
+					t.Errorf("attribute %q: required = %v with optional = %v", f.Name, attr.Required, f.Optional)
+				}
+				if f.Sensitive {
+					secrets++
+				}
+			}
+			if secrets == 0 {
+				t.Error("expected at least one sensitive credential attribute")
+			}
+		})
+	}
+}
+
+func TestSaasFieldSetAfterImport(t *testing.T) {
+	ctx := context.Background()
+	exists := tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})
+	absent := tftypes.NewValue(tftypes.Object{}, nil)
+
+	for name, tc := range map[string]struct {
+		state       tftypes.Value
+		stateValue  types.String
+		configValue types.String
+		warn        bool
+	}{
+		"set after import": {exists, types.StringNull(), types.StringValue("secret"), true},
+		"create":           {absent, types.StringNull(), types.StringValue("secret"), false},
+		"unchanged":        {exists, types.StringValue("secret"), types.StringValue("secret"), false},
+		"unset":            {exists, types.StringNull(), types.StringNull(), false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			req := planmodifier.StringRequest{
+				Path:        path.Root("api_key"),
+				State:       tfsdk.State{Raw: tc.state},
+				Plan:        tfsdk.Plan{Raw: exists},
+				StateValue:  tc.stateValue,
+				ConfigValue: tc.configValue,
+				PlanValue:   tc.configValue,
+			}
+			var resp planmodifier.StringResponse
+			saasFieldSetAfterImport{}.PlanModifyString(ctx, req, &resp)
+			if got := resp.Diagnostics.WarningsCount() == 1; got != tc.warn {
+				t.Errorf("expected warning %v, got %v", tc.warn, resp.Diagnostics)
+			}
+		})
+	}
+}
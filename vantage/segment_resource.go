@@ -20,6 +20,7 @@ var (
 	_ resource.Resource                = (*SegmentResource)(nil)
 	_ resource.ResourceWithConfigure   = (*SegmentResource)(nil)
 	_ resource.ResourceWithImportState = (*SegmentResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*SegmentResource)(nil)
 )
 
 type SegmentResource struct {
@@ -37,6 +38,7 @@ type SegmentResourceModel struct {
 	WorkspaceToken     types.String `tfsdk:"workspace_token"`
 	ReportToken        types.String `tfsdk:"report_token"`
 	Filter             types.String `tfsdk:"filter"`
+	FilterRules        types.Object `tfsdk:"filter_rules"`
 	ParentSegmentToken types.String `tfsdk:"parent_segment_token"`
 	Token              types.String `tfsdk:"token"`
 	TrackUnallocated   types.Bool   `tfsdk:"track_unallocated"`
@@ -88,6 +90,7 @@ func (r SegmentResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:            true,
 				Default:             stringdefault.StaticString(""),
 			},
+			"filter_rules": filterRulesAttribute("costs"),
 			"track_unallocated": schema.BoolAttribute{
 				MarkdownDescription: "Whether or not to track unallocated resources in this Segment.",
 				Computed:            true,
@@ -103,6 +106,10 @@ func (r SegmentResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+func (r SegmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanFilterRules(ctx, "costs", req, resp)
+}
+
 func (r SegmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *SegmentResourceModel
 
@@ -139,7 +146,7 @@ func (r SegmentResource) Create(ctx context.Context, req resource.CreateRequest,
 	data.WorkspaceToken = types.StringValue(out.Payload.WorkspaceToken)
 	data.ParentSegmentToken = types.StringPointerValue(out.Payload.ParentSegmentToken)
 	data.Title = types.StringValue(out.Payload.Title)
-	data.Filter = stringPointerOrEmpty(out.Payload.Filter)
+	data.Filter = equivalentFilter(data.Filter, stringPointerOrEmpty(out.Payload.Filter))
 	data.Priority = types.Int64Value(int64(out.Payload.Priority))
 	data.TrackUnallocated = types.BoolValue(out.Payload.TrackUnallocated)
 	data.ReportToken = types.StringPointerValue(out.Payload.ReportToken)
@@ -174,7 +181,7 @@ func (r SegmentResource) Read(ctx context.Context, req resource.ReadRequest, res
 	state.WorkspaceToken = types.StringValue(out.Payload.WorkspaceToken)
 	state.ReportToken = types.StringPointerValue(out.Payload.ReportToken)
 	state.ParentSegmentToken = types.StringPointerValue(out.Payload.ParentSegmentToken)
-	state.Filter = stringPointerOrEmpty(out.Payload.Filter)
+	state.Filter = equivalentFilter(state.Filter, stringPointerOrEmpty(out.Payload.Filter))
 	state.Priority = types.Int64Value(int64(out.Payload.Priority))
 	state.TrackUnallocated = types.BoolValue(out.Payload.TrackUnallocated)
 
@@ -221,7 +228,7 @@ func (r SegmentResource) Update(ctx context.Context, req resource.UpdateRequest,
 		data.Description = types.StringValue(out.Payload.Description)
 	}
 
-	data.Filter = stringPointerOrEmpty(out.Payload.Filter)
+	data.Filter = equivalentFilter(data.Filter, stringPointerOrEmpty(out.Payload.Filter))
 	data.Title = types.StringValue(out.Payload.Title)
 	data.TrackUnallocated = types.BoolValue(out.Payload.TrackUnallocated)
 
@@ -0,0 +1,41 @@
+package vantage
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	integrationsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/integrations"
+)
+
+func NewSnowflakeIntegrationResource() resource.Resource {
+	return newSaasIntegrationResource(saasIntegrationSpec{
+		TypeName:    "snowflake_integration",
+		DisplayName: "Snowflake",
+		Description: "Manages a Snowflake integration, which imports Snowflake usage costs and can source `snowflake_metric_fields` of a `vantage_business_metric`.",
+		Fields: []saasIntegrationField{
+			{Name: "account_identifier", Description: "The Snowflake account identifier (e.g. `myorg-myaccount`)."},
+			{Name: "username", Description: "The Snowflake user Vantage connects as."},
+			{Name: "warehouse", Description: "The warehouse Vantage runs its usage queries on."},
+			{Name: "private_key", Description: "The PEM-encoded private key of the user's key pair.", Sensitive: true},
+		},
+		Create: func(client *Client, values map[string]*string) (*modelsv2.Integration, error) {
+			params := integrationsv2.NewCreateSnowflakeIntegrationParams()
+			params.WithCreateSnowflakeIntegration(&modelsv2.CreateSnowflakeIntegration{
+				AccountIdentifier: values["account_identifier"],
+				Username:          values["username"],
+				Warehouse:         values["warehouse"],
+				PrivateKey:        values["private_key"],
+			})
+			out, err := client.V2.Integrations.CreateSnowflakeIntegration(params, client.Auth)
+			if err != nil {
+				return nil, err
+			}
+			return out.Payload, nil
+		},
+		BadRequest: func(err error) *modelsv2.Errors {
+			if e, ok := err.(*integrationsv2.CreateSnowflakeIntegrationBadRequest); ok {
+				return e.GetPayload()
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,165 @@
+package vantage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	tagsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/tags"
+)
+
+var (
+	_ datasource.DataSource              = (*tagValuesDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*tagValuesDataSource)(nil)
+)
+
+func NewTagValuesDataSource() datasource.DataSource {
+	return &tagValuesDataSource{}
+}
+
+type tagValueItemModel struct {
+	TagValue  types.String `tfsdk:"tag_value"`
+	Providers types.List   `tfsdk:"providers"`
+}
+
+type tagValuesDataSourceModel struct {
+	TagKey         types.String        `tfsdk:"tag_key"`
+	Providers      types.List          `tfsdk:"providers"`
+	WorkspaceToken types.String        `tfsdk:"workspace_token"`
+	Search         types.String        `tfsdk:"search"`
+	Values         types.List          `tfsdk:"values"`
+	TagValues      []tagValueItemModel `tfsdk:"tag_values"`
+}
+
+type tagValuesDataSource struct {
+	client *Client
+}
+
+func (d *tagValuesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*Client)
+}
+
+func (d *tagValuesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag_values"
+}
+
+func (d *tagValuesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns every value of a tag key, paging through the Get All Tag Values API endpoint. Optionally filtered by provider, workspace and search text.",
+		Attributes: map[string]schema.Attribute{
+			"tag_key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The tag key to return values for.",
+			},
+			"providers": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only return values present on costs from these providers (e.g. `[\"aws\"]`).",
+			},
+			"workspace_token": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return values present in this Workspace.",
+			},
+			"search": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return values containing this text. Corresponds to the `search_query` query parameter on the Get All Tag Values API endpoint.",
+			},
+			"values": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The tag values as a flat list of strings, in the order returned by the API.",
+			},
+			"tag_values": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The list of tag values returned by the API.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"tag_value": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The tag value.",
+						},
+						"providers": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The providers the tag value is present on.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *tagValuesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state tagValuesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := tagsFilter{
+		WorkspaceToken: state.WorkspaceToken.ValueStringPointer(),
+		SearchQuery:    state.Search.ValueStringPointer(),
+	}
+	if !state.Providers.IsNull() && !state.Providers.IsUnknown() {
+		resp.Diagnostics.Append(state.Providers.ElementsAs(ctx, &filter.Providers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	allValues, err := fetchAllTagValues(d.client, state.TagKey.ValueString(), filter)
+	if err != nil {
+		handleError("Read Tag Values", &resp.Diagnostics, err)
+		return
+	}
+
+	values := make([]string, 0, len(allValues))
+	state.TagValues = make([]tagValueItemModel, 0, len(allValues))
+	for _, value := range allValues {
+		providers, diags := types.ListValueFrom(ctx, types.StringType, value.Providers)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		values = append(values, value.TagValue)
+		state.TagValues = append(state.TagValues, tagValueItemModel{
+			TagValue:  types.StringValue(value.TagValue),
+			Providers: providers,
+		})
+	}
+
+	list, diags := stringListFrom(values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Values = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// fetchAllTagValues returns every value of the tag key matching filter,
+// across all pages of the Get All Tag Values endpoint.
+func fetchAllTagValues(client *Client, key string, filter tagsFilter) ([]*modelsv2.TagValue, error) {
+	return fetchAllPages(func(limit int32, page *int32) ([]*modelsv2.TagValue, *modelsv2.Links, error) {
+		params := tagsv2.NewGetTagValuesParams()
+		params.SetKey(key)
+		params.SetLimit(&limit)
+		params.SetProviders(filter.Providers)
+		params.SetWorkspaceToken(filter.WorkspaceToken)
+		params.SetSearchQuery(filter.SearchQuery)
+		params.SetPage(page)
+		out, err := client.V2.Tags.GetTagValues(params, client.Auth)
+		if err != nil {
+			return nil, nil, err
+		}
+		return out.Payload.TagValues, out.Payload.Links, nil
+	})
+}
@@ -0,0 +1,158 @@
+package vantage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	tagsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/tags"
+)
+
+var (
+	_ datasource.DataSource              = (*tagsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*tagsDataSource)(nil)
+)
+
+func NewTagsDataSource() datasource.DataSource {
+	return &tagsDataSource{}
+}
+
+type tagItemModel struct {
+	TagKey    types.String `tfsdk:"tag_key"`
+	Hidden    types.Bool   `tfsdk:"hidden"`
+	Providers types.List   `tfsdk:"providers"`
+}
+
+type tagsDataSourceModel struct {
+	Providers      types.List     `tfsdk:"providers"`
+	WorkspaceToken types.String   `tfsdk:"workspace_token"`
+	Search         types.String   `tfsdk:"search"`
+	Tags           []tagItemModel `tfsdk:"tags"`
+}
+
+// tagsFilter holds the query parameters shared by the Get All Tags and Get
+// All Tag Values endpoints. Nil or empty fields are omitted from the request.
+type tagsFilter struct {
+	Providers      []string
+	WorkspaceToken *string
+	SearchQuery    *string
+}
+
+type tagsDataSource struct {
+	client *Client
+}
+
+func (d *tagsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*Client)
+}
+
+func (d *tagsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tags"
+}
+
+func (d *tagsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns the tag keys present on costs, optionally filtered by provider, workspace and search text. Useful for building VQL filters and `vantage_virtual_tag_config` rules from actual account tags.",
+		Attributes: map[string]schema.Attribute{
+			"providers": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only return tag keys present on costs from these providers (e.g. `[\"aws\", \"gcp\"]`).",
+			},
+			"workspace_token": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return tag keys present in this Workspace.",
+			},
+			"search": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return tag keys containing this text. Corresponds to the `search_query` query parameter on the Get All Tags API endpoint.",
+			},
+			"tags": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The list of tag keys returned by the API.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"tag_key": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The tag key.",
+						},
+						"hidden": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the tag key has been hidden in the Vantage console.",
+						},
+						"providers": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The providers the tag key is present on.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *tagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state tagsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := tagsFilter{
+		WorkspaceToken: state.WorkspaceToken.ValueStringPointer(),
+		SearchQuery:    state.Search.ValueStringPointer(),
+	}
+	if !state.Providers.IsNull() && !state.Providers.IsUnknown() {
+		resp.Diagnostics.Append(state.Providers.ElementsAs(ctx, &filter.Providers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	allTags, err := fetchAllTags(d.client, filter)
+	if err != nil {
+		handleError("Read Tags", &resp.Diagnostics, err)
+		return
+	}
+
+	state.Tags = make([]tagItemModel, 0, len(allTags))
+	for _, tag := range allTags {
+		providers, diags := types.ListValueFrom(ctx, types.StringType, tag.Providers)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.Tags = append(state.Tags, tagItemModel{
+			TagKey:    types.StringValue(tag.TagKey),
+			Hidden:    types.BoolValue(tag.Hidden),
+			Providers: providers,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// fetchAllTags returns every tag key matching filter, across all pages of
+// the Get All Tags endpoint.
+func fetchAllTags(client *Client, filter tagsFilter) ([]*modelsv2.Tag, error) {
+	return fetchAllPages(func(limit int32, page *int32) ([]*modelsv2.Tag, *modelsv2.Links, error) {
+		params := tagsv2.NewGetTagsParams()
+		params.SetLimit(&limit)
+		params.SetProviders(filter.Providers)
+		params.SetWorkspaceToken(filter.WorkspaceToken)
+		params.SetSearchQuery(filter.SearchQuery)
+		params.SetPage(page)
+		out, err := client.V2.Tags.GetTags(params, client.Auth)
+		if err != nil {
+			return nil, nil, err
+		}
+		return out.Payload.Tags, out.Payload.Links, nil
+	})
+}
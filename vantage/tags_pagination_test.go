@@ -0,0 +1,154 @@
+package vantage
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+)
+
+// ---------------------------------------------------------------------------
+// fetchAllTags / fetchAllTagValues unit tests — driven by a mock HTTP server
+// ---------------------------------------------------------------------------
+
+type tagsResponse struct {
+	Tags  []*modelsv2.Tag `json:"tags"`
+	Links *modelsv2.Links `json:"links,omitempty"`
+}
+
+type tagValuesResponse struct {
+	TagValues []*modelsv2.TagValue `json:"tag_values"`
+	Links     *modelsv2.Links      `json:"links,omitempty"`
+}
+
+// nextLink returns a links object pointing at page+1 of the request's path
+// when there are more pages to serve, and nil otherwise.
+func nextLink(r *http.Request, pageNum, pageCount int) *modelsv2.Links {
+	if pageNum >= pageCount {
+		return nil
+	}
+	next := fmt.Sprintf("http://%s%s?limit=1000&page=%d", r.Host, r.URL.Path, pageNum+1)
+	return &modelsv2.Links{Next: &next}
+}
+
+func requestedPage(r *http.Request) int {
+	pageNum := 1
+	if pageStr := r.URL.Query().Get("page"); pageStr != "" {
+		fmt.Sscanf(pageStr, "%d", &pageNum)
+	}
+	return pageNum
+}
+
+func TestFetchAllTags_multiplePages(t *testing.T) {
+	pages := [][]*modelsv2.Tag{
+		{{TagKey: "team", Providers: []string{"aws"}}},
+		{{TagKey: "env", Providers: []string{"aws", "gcp"}}, {TagKey: "cost-center", Providers: []string{"azure"}}},
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/tags" {
+			http.NotFound(w, r)
+			return
+		}
+		pageNum := requestedPage(r)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(tagsResponse{Tags: pages[pageNum-1], Links: nextLink(r, pageNum, len(pages))})
+	}))
+	defer srv.Close()
+
+	got, err := fetchAllTags(clientForServer(t, srv.URL), tagsFilter{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"team", "env", "cost-center"}
+	if len(got) != len(want) {
+		t.Fatalf("got %d tags, want %d", len(got), len(want))
+	}
+	for i, key := range want {
+		if got[i].TagKey != key {
+			t.Errorf("index %d: got key %q, want %q", i, got[i].TagKey, key)
+		}
+	}
+}
+
+func TestFetchAllTags_forwardsFilters(t *testing.T) {
+	var capturedSearch, capturedWorkspace, capturedProviders string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		capturedSearch = q.Get("search_query")
+		capturedWorkspace = q.Get("workspace_token")
+		capturedProviders = strings.Join(q["providers"], ",")
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(tagsResponse{Tags: []*modelsv2.Tag{}})
+	}))
+	defer srv.Close()
+
+	search := "team"
+	workspace := "wrkspc_1"
+	_, err := fetchAllTags(clientForServer(t, srv.URL), tagsFilter{
+		Providers:      []string{"aws"},
+		WorkspaceToken: &workspace,
+		SearchQuery:    &search,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if capturedSearch != search {
+		t.Errorf("search_query not forwarded: got %q, want %q", capturedSearch, search)
+	}
+	if capturedWorkspace != workspace {
+		t.Errorf("workspace_token not forwarded: got %q, want %q", capturedWorkspace, workspace)
+	}
+	if capturedProviders != "aws" {
+		t.Errorf("providers not forwarded: got %q, want %q", capturedProviders, "aws")
+	}
+}
+
+func TestFetchAllTagValues_multiplePages(t *testing.T) {
+	pages := [][]*modelsv2.TagValue{
+		{{TagValue: "platform"}, {TagValue: "data"}},
+		{{TagValue: "web"}},
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/tags/team/values" {
+			http.NotFound(w, r)
+			return
+		}
+		pageNum := requestedPage(r)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(tagValuesResponse{TagValues: pages[pageNum-1], Links: nextLink(r, pageNum, len(pages))})
+	}))
+	defer srv.Close()
+
+	got, err := fetchAllTagValues(clientForServer(t, srv.URL), "team", tagsFilter{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"platform", "data", "web"}
+	if len(got) != len(want) {
+		t.Fatalf("got %d tag values, want %d", len(got), len(want))
+	}
+	for i, v := range want {
+		if got[i].TagValue != v {
+			t.Errorf("index %d: got value %q, want %q", i, got[i].TagValue, v)
+		}
+	}
+}
+
+func TestFetchAllTagValues_apiError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	_, err := fetchAllTagValues(clientForServer(t, srv.URL), "team", tagsFilter{})
+	if err == nil {
+		t.Fatal("expected error from API, got nil")
+	}
+}
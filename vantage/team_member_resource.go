@@ -0,0 +1,336 @@
+package vantage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	teamsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/teams"
+)
+
+var (
+	_ resource.Resource                     = (*TeamMemberResource)(nil)
+	_ resource.ResourceWithConfigure        = (*TeamMemberResource)(nil)
+	_ resource.ResourceWithImportState      = (*TeamMemberResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*TeamMemberResource)(nil)
+)
+
+// teamMembershipLocks serializes read-modify-write updates of a team's
+// members within the provider, so that vantage_team_member resources (and a
+// vantage_team that leaves user_tokens unset) applied in parallel against the
+// same team don't overwrite each other's changes.
+var teamMembershipLocks = &keyedMutex{locks: map[string]*sync.Mutex{}}
+
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+func (k *keyedMutex) Lock(key string) {
+	k.mu.Lock()
+	l, ok := k.locks[key]
+	if !ok {
+		l = &sync.Mutex{}
+		k.locks[key] = l
+	}
+	k.mu.Unlock()
+	l.Lock()
+}
+
+func (k *keyedMutex) Unlock(key string) {
+	k.mu.Lock()
+	l := k.locks[key]
+	k.mu.Unlock()
+	l.Unlock()
+}
+
+type TeamMemberResource struct{ client *Client }
+
+func NewTeamMemberResource() resource.Resource { return &TeamMemberResource{} }
+
+type TeamMemberResourceModel struct {
+	TeamToken types.String `tfsdk:"team_token"`
+	UserToken types.String `tfsdk:"user_token"`
+	UserEmail types.String `tfsdk:"user_email"`
+	Id        types.String `tfsdk:"id"`
+}
+
+func (r *TeamMemberResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *TeamMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_member"
+}
+
+func (r *TeamMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"team_token": schema.StringAttribute{
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				MarkdownDescription: "The token of the Team to add the User to.",
+			},
+			"user_token": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "The token of the User. Exactly one of `user_token` or `user_email` must be set; the other is computed.",
+			},
+			"user_email": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "The email of the User. Exactly one of `user_token` or `user_email` must be set; the other is computed.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "The id of the membership, in the form `<team_token>/<user_token or user_email>`.",
+			},
+		},
+		MarkdownDescription: "Manages the membership of a single User in a Team without taking ownership of the rest of the Team's members. Use it instead of `user_tokens`/`user_emails` on `vantage_team` when several configurations add people to the same Team.",
+	}
+}
+
+func (r *TeamMemberResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("user_token"),
+			path.MatchRoot("user_email"),
+		),
+	}
+}
+
+func (r *TeamMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamToken, user, ok := strings.Cut(req.ID, "/")
+	if !ok || teamToken == "" || user == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import id of the form <team_token>/<user_token or user_email>, got %q.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(req.ID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_token"), types.StringValue(teamToken))...)
+	if strings.Contains(user, "@") {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_email"), types.StringValue(user))...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_token"), types.StringValue(user))...)
+	}
+}
+
+func (r *TeamMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamToken := data.TeamToken.ValueString()
+	byEmail := !data.UserEmail.IsNull() && !data.UserEmail.IsUnknown()
+
+	team, err := updateTeamMembers(r.client, teamToken, func(team *modelsv2.Team) *modelsv2.UpdateTeam {
+		if byEmail {
+			if teamMemberIndex(team, "", data.UserEmail.ValueString()) >= 0 {
+				return nil
+			}
+			update := teamUpdateFrom(team)
+			update.UserEmails = append(append([]string{}, team.UserEmails...), data.UserEmail.ValueString())
+			return update
+		}
+		if teamMemberIndex(team, data.UserToken.ValueString(), "") >= 0 {
+			return nil
+		}
+		update := teamUpdateFrom(team)
+		update.UserTokens = append(append([]string{}, team.UserTokens...), data.UserToken.ValueString())
+		return update
+	})
+	if err != nil {
+		if e, ok := err.(*teamsv2.UpdateTeamBadRequest); ok {
+			handleBadRequest("Create Team Member", &resp.Diagnostics, e.GetPayload())
+			return
+		}
+		handleError("Create Team Member", &resp.Diagnostics, err)
+		return
+	}
+
+	// The id keeps the identifier the membership was configured with.
+	if byEmail {
+		data.Id = types.StringValue(teamToken + "/" + data.UserEmail.ValueString())
+	} else {
+		data.Id = types.StringValue(teamToken + "/" + data.UserToken.ValueString())
+	}
+
+	if !data.setFromTeam(team) {
+		resp.Diagnostics.AddError(
+			"Team Member Not Added",
+			fmt.Sprintf("The User was not a member of Team %s after the update. Check that the User exists and belongs to the account.", teamToken),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TeamMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := teamsv2.NewGetTeamParams()
+	params.SetTeamToken(state.TeamToken.ValueString())
+	out, err := r.client.V2.Teams.GetTeam(params, r.client.Auth)
+	if err != nil {
+		if _, ok := err.(*teamsv2.GetTeamNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		handleError("Read Team Member", &resp.Diagnostics, err)
+		return
+	}
+
+	// The User was removed from the Team outside of this resource.
+	if !state.setFromTeam(out.Payload) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *TeamMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable attribute forces replacement; Update only runs when
+	// a computed attribute changes, so the plan is stored as-is.
+	var plan TeamMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TeamMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TeamMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := updateTeamMembers(r.client, state.TeamToken.ValueString(), func(team *modelsv2.Team) *modelsv2.UpdateTeam {
+		i := teamMemberIndex(team, state.UserToken.ValueString(), state.UserEmail.ValueString())
+		if i < 0 {
+			return nil
+		}
+		update := teamUpdateFrom(team)
+		update.UserTokens = append(append([]string{}, team.UserTokens[:i]...), team.UserTokens[i+1:]...)
+		return update
+	})
+	if err != nil {
+		if _, ok := err.(*teamsv2.GetTeamNotFound); ok {
+			return
+		}
+		handleError("Delete Team Member", &resp.Diagnostics, err)
+	}
+}
+
+// setFromTeam fills the computed user attributes from team and reports
+// whether the User is a member of it.
+func (m *TeamMemberResourceModel) setFromTeam(team *modelsv2.Team) bool {
+	i := teamMemberIndex(team, m.UserToken.ValueString(), m.UserEmail.ValueString())
+	if i < 0 {
+		return false
+	}
+
+	// Only the identifier that is not configured is filled in, so that a
+	// configured email is kept even if the API returns it in another case.
+	if m.UserToken.IsNull() || m.UserToken.IsUnknown() {
+		m.UserToken = types.StringValue(team.UserTokens[i])
+	}
+	if m.UserEmail.IsNull() || m.UserEmail.IsUnknown() {
+		m.UserEmail = types.StringNull()
+		if i < len(team.UserEmails) {
+			m.UserEmail = types.StringValue(team.UserEmails[i])
+		}
+	}
+	return true
+}
+
+// teamMemberIndex returns the index of the User identified by userToken or,
+// if userToken is empty, by userEmail in the Team's members, or -1 if the User
+// is not a member. user_tokens and user_emails are returned in the same order,
+// so the index is valid for both. Emails are compared case-insensitively.
+func teamMemberIndex(team *modelsv2.Team, userToken, userEmail string) int {
+	if userToken != "" {
+		for i, t := range team.UserTokens {
+			if t == userToken {
+				return i
+			}
+		}
+		return -1
+	}
+	for i, e := range team.UserEmails {
+		if strings.EqualFold(e, userEmail) && i < len(team.UserTokens) {
+			return i
+		}
+	}
+	return -1
+}
+
+// teamUpdateFrom returns an UpdateTeam that leaves every attribute of team
+// unchanged, for callers to modify the members of.
+func teamUpdateFrom(team *modelsv2.Team) *modelsv2.UpdateTeam {
+	update := &modelsv2.UpdateTeam{
+		Name:                  team.Name,
+		DefaultDashboardToken: team.DefaultDashboardToken,
+		WorkspaceTokens:       team.WorkspaceTokens,
+	}
+	if team.Description != nil {
+		update.Description = *team.Description
+	}
+	return update
+}
+
+// updateTeamMembers reads the Team and, if mutate returns an update, applies
+// it, holding the Team's membership lock throughout. mutate returns nil when
+// no change is needed. It returns the Team as it is after the update.
+func updateTeamMembers(client *Client, teamToken string, mutate func(team *modelsv2.Team) *modelsv2.UpdateTeam) (*modelsv2.Team, error) {
+	teamMembershipLocks.Lock(teamToken)
+	defer teamMembershipLocks.Unlock(teamToken)
+
+	getParams := teamsv2.NewGetTeamParams()
+	getParams.SetTeamToken(teamToken)
+	current, err := client.V2.Teams.GetTeam(getParams, client.Auth)
+	if err != nil {
+		return nil, err
+	}
+
+	update := mutate(current.Payload)
+	if update == nil {
+		return current.Payload, nil
+	}
+
+	params := teamsv2.NewUpdateTeamParams()
+	params.WithTeamToken(teamToken)
+	params.WithUpdateTeam(update)
+	out, err := client.V2.Teams.UpdateTeam(params, client.Auth)
+	if err != nil {
+		return nil, err
+	}
+	return out.Payload, nil
+}
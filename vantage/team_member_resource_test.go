@@ -0,0 +1,167 @@
+package vantage
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+)
+
+// ---------------------------------------------------------------------------
+// updateTeamMembers unit tests — driven by a mock HTTP server
+// ---------------------------------------------------------------------------
+
+// newMockTeamServer serves GET and PUT /v2/teams/team_1 against an in-memory
+// team. PUT replaces the members with whichever of user_tokens or user_emails
+// is sent, resolving emails through directory.
+func newMockTeamServer(t *testing.T, team *modelsv2.Team, directory map[string]string) *httptest.Server {
+	t.Helper()
+	var mu sync.Mutex
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/teams/team_1" {
+			http.NotFound(w, r)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.Method == http.MethodPut {
+			var update modelsv2.UpdateTeam
+			if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			team.Name = update.Name
+			team.WorkspaceTokens = update.WorkspaceTokens
+			switch {
+			case len(update.UserEmails) > 0:
+				team.UserEmails = update.UserEmails
+				team.UserTokens = nil
+				for _, email := range update.UserEmails {
+					team.UserTokens = append(team.UserTokens, directory[email])
+				}
+			default:
+				team.UserTokens = update.UserTokens
+				team.UserEmails = nil
+				for _, token := range update.UserTokens {
+					for email, tok := range directory {
+						if tok == token {
+							team.UserEmails = append(team.UserEmails, email)
+						}
+					}
+				}
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(team)
+	}))
+}
+
+func TestUpdateTeamMembers_addByEmailPreservesTeam(t *testing.T) {
+	directory := map[string]string{"a@example.com": "usr_a", "b@example.com": "usr_b"}
+	team := &modelsv2.Team{
+		Token:           "team_1",
+		Name:            "Platform",
+		UserTokens:      []string{"usr_a"},
+		UserEmails:      []string{"a@example.com"},
+		WorkspaceTokens: []string{"wrkspc_1"},
+	}
+	srv := newMockTeamServer(t, team, directory)
+	defer srv.Close()
+
+	got, err := updateTeamMembers(clientForServer(t, srv.URL), "team_1", func(team *modelsv2.Team) *modelsv2.UpdateTeam {
+		update := teamUpdateFrom(team)
+		update.UserEmails = append(append([]string{}, team.UserEmails...), "b@example.com")
+		return update
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Name != "Platform" || len(got.WorkspaceTokens) != 1 {
+		t.Errorf("team attributes not preserved: %+v", got)
+	}
+
+	m := TeamMemberResourceModel{}
+	m.UserEmail = types.StringValue("B@example.com")
+	if !m.setFromTeam(got) {
+		t.Fatal("expected b@example.com to be a member")
+	}
+	if m.UserToken.ValueString() != "usr_b" {
+		t.Errorf("got user_token %q, want %q", m.UserToken.ValueString(), "usr_b")
+	}
+	if m.UserEmail.ValueString() != "B@example.com" {
+		t.Errorf("configured user_email was overwritten: %q", m.UserEmail.ValueString())
+	}
+}
+
+func TestUpdateTeamMembers_noChangeSkipsUpdate(t *testing.T) {
+	puts := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			puts++
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&modelsv2.Team{Token: "team_1", UserTokens: []string{"usr_a"}})
+	}))
+	defer srv.Close()
+
+	_, err := updateTeamMembers(clientForServer(t, srv.URL), "team_1", func(team *modelsv2.Team) *modelsv2.UpdateTeam {
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if puts != 0 {
+		t.Errorf("got %d updates, want 0", puts)
+	}
+}
+
+func TestUpdateTeamMembers_concurrentAdds(t *testing.T) {
+	directory := map[string]string{}
+	team := &modelsv2.Team{Token: "team_1", Name: "Platform"}
+	srv := newMockTeamServer(t, team, directory)
+	defer srv.Close()
+	client := clientForServer(t, srv.URL)
+
+	tokens := []string{"usr_1", "usr_2", "usr_3", "usr_4", "usr_5"}
+	var wg sync.WaitGroup
+	for _, token := range tokens {
+		wg.Add(1)
+		go func(token string) {
+			defer wg.Done()
+			_, err := updateTeamMembers(client, "team_1", func(team *modelsv2.Team) *modelsv2.UpdateTeam {
+				update := teamUpdateFrom(team)
+				update.UserTokens = append(append([]string{}, team.UserTokens...), token)
+				return update
+			})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}(token)
+	}
+	wg.Wait()
+
+	if len(team.UserTokens) != len(tokens) {
+		t.Errorf("got %d members, want %d: lost updates %v", len(team.UserTokens), len(tokens), team.UserTokens)
+	}
+}
+
+func TestTeamMemberIndex(t *testing.T) {
+	team := &modelsv2.Team{
+		UserTokens: []string{"usr_a", "usr_b"},
+		UserEmails: []string{"a@example.com", "b@example.com"},
+	}
+	if got := teamMemberIndex(team, "usr_b", ""); got != 1 {
+		t.Errorf("by token: got %d, want 1", got)
+	}
+	if got := teamMemberIndex(team, "", "A@Example.com"); got != 0 {
+		t.Errorf("by email: got %d, want 0", got)
+	}
+	if got := teamMemberIndex(team, "usr_c", "a@example.com"); got != -1 {
+		t.Errorf("token takes precedence: got %d, want -1", got)
+	}
+}
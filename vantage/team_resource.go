@@ -247,6 +247,11 @@ func (r TeamResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Hold the Team's membership lock so that vantage_team_member resources
+	// for this Team are not overwritten by the member list sent below.
+	teamMembershipLocks.Lock(data.Token.ValueString())
+	defer teamMembershipLocks.Unlock(data.Token.ValueString())
+
 	params := teamsv2.NewUpdateTeamParams()
 	params.WithTeamToken(data.Token.ValueString())
 
@@ -261,7 +266,9 @@ func (r TeamResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	// The API accepts user_tokens or user_emails, but rejects requests that
 	// contain both as non-empty arrays. Read populates both computed fields, so
 	// use config to preserve the identifier type selected by the practitioner.
-	// Imported teams have neither field in config; prefer tokens for them.
+	// When neither field is in config (imported teams, or teams whose members
+	// are managed by vantage_team_member), resend the current members read
+	// under the lock rather than the possibly stale state.
 	switch {
 	case userTokensNonEmpty && userEmailsNonEmpty:
 		resp.Diagnostics.AddError(
@@ -278,9 +285,14 @@ func (r TeamResource) Update(ctx context.Context, req resource.UpdateRequest, re
 			resp.Diagnostics.Append(data.UserEmails.ElementsAs(ctx, &userEmails, false)...)
 		}
 	default:
-		if !state.UserTokens.IsNull() && !state.UserTokens.IsUnknown() {
-			resp.Diagnostics.Append(state.UserTokens.ElementsAs(ctx, &userTokens, false)...)
+		getParams := teamsv2.NewGetTeamParams()
+		getParams.SetTeamToken(state.Token.ValueString())
+		current, err := r.client.V2.Teams.GetTeam(getParams, r.client.Auth)
+		if err != nil {
+			handleError("Update Team Resource", &resp.Diagnostics, err)
+			return
 		}
+		userTokens = append(userTokens, current.Payload.UserTokens...)
 	}
 	if resp.Diagnostics.HasError() {
 		return
@@ -0,0 +1,250 @@
+package vantage
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vantage-sh/terraform-provider-vantage/vantage/planmodifiers"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	usersv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/users"
+)
+
+var (
+	_ resource.Resource                = (*UserResource)(nil)
+	_ resource.ResourceWithConfigure   = (*UserResource)(nil)
+	_ resource.ResourceWithImportState = (*UserResource)(nil)
+)
+
+type UserResource struct{ client *Client }
+
+func NewUserResource() resource.Resource { return &UserResource{} }
+
+type UserResourceModel struct {
+	Email           types.String `tfsdk:"email"`
+	Role            types.String `tfsdk:"role"`
+	TeamTokens      types.Set    `tfsdk:"team_tokens"`
+	WorkspaceTokens types.Set    `tfsdk:"workspace_tokens"`
+	Name            types.String `tfsdk:"name"`
+	Status          types.String `tfsdk:"status"`
+	Token           types.String `tfsdk:"token"`
+	Id              types.String `tfsdk:"id"`
+}
+
+func (r *UserResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *UserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *UserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				MarkdownDescription: "The email address to invite. Changing it removes the User and invites the new address.",
+			},
+			"role": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("editor"),
+				Validators:          []validator.String{stringvalidator.OneOf("owner", "editor", "viewer")},
+				MarkdownDescription: "The account role of the User. One of `owner`, `editor` or `viewer`. Defaults to `editor`. Can be changed in place.",
+			},
+			"team_tokens": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers:       []planmodifier.Set{planmodifiers.SetImmutableAfterCreate("team_tokens")},
+				MarkdownDescription: "The tokens of the Teams to add the User to when invited. Only applied on creation; manage later changes with `vantage_team_member`.",
+			},
+			"workspace_tokens": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers:       []planmodifier.Set{planmodifiers.SetImmutableAfterCreate("workspace_tokens")},
+				MarkdownDescription: "The tokens of the Workspaces to give the User access to when invited. Only applied on creation.",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the User. Null until the invitation is accepted.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the invitation: `pending` until the User accepts it, then `accepted`.",
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "The token of the User.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "Same as token.",
+			},
+		},
+		MarkdownDescription: "Invites a User to the Vantage account and manages their role. Destroying the resource removes the User from the account, or revokes the invitation if it has not been accepted.",
+	}
+}
+
+func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("token"), req, resp)
+}
+
+func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := &modelsv2.CreateUser{
+		Email: data.Email.ValueStringPointer(),
+		Role:  data.Role.ValueString(),
+	}
+	if !data.TeamTokens.IsNull() && !data.TeamTokens.IsUnknown() {
+		resp.Diagnostics.Append(data.TeamTokens.ElementsAs(ctx, &payload.TeamTokens, false)...)
+	}
+	if !data.WorkspaceTokens.IsNull() && !data.WorkspaceTokens.IsUnknown() {
+		resp.Diagnostics.Append(data.WorkspaceTokens.ElementsAs(ctx, &payload.WorkspaceTokens, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := usersv2.NewCreateUserParams()
+	params.WithCreateUser(payload)
+	out, err := r.client.V2.Users.CreateUser(params, r.client.Auth)
+	if err != nil {
+		if e, ok := err.(*usersv2.CreateUserBadRequest); ok {
+			handleBadRequest("Create User", &resp.Diagnostics, e.GetPayload())
+			return
+		}
+		handleError("Create User", &resp.Diagnostics, err)
+		return
+	}
+
+	data.applyPayload(out.Payload)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state UserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := usersv2.NewGetUserParams()
+	params.SetUserToken(state.Token.ValueString())
+	out, err := r.client.V2.Users.GetUser(params, r.client.Auth)
+	if err != nil {
+		if _, ok := err.(*usersv2.GetUserNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		handleError("Read User", &resp.Diagnostics, err)
+		return
+	}
+
+	// team_tokens and workspace_tokens are only applied on creation and are
+	// kept from state; current memberships may have changed since.
+	state.Email = userEmail(state.Email, out.Payload.Email)
+	state.applyPayload(out.Payload)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// email forces replacement and the initial assignments are kept from
+	// state by their plan modifiers, so only the role is sent.
+	var plan UserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state UserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := usersv2.NewUpdateUserParams()
+	params.SetUserToken(state.Token.ValueString())
+	params.WithUpdateUser(&modelsv2.UpdateUser{
+		Role: plan.Role.ValueString(),
+	})
+	out, err := r.client.V2.Users.UpdateUser(params, r.client.Auth)
+	if err != nil {
+		if e, ok := err.(*usersv2.UpdateUserBadRequest); ok {
+			handleBadRequest("Update User", &resp.Diagnostics, e.GetPayload())
+			return
+		}
+		handleError("Update User", &resp.Diagnostics, err)
+		return
+	}
+
+	plan.applyPayload(out.Payload)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state UserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := usersv2.NewDeleteUserParams()
+	params.SetUserToken(state.Token.ValueString())
+	_, err := r.client.V2.Users.DeleteUser(params, r.client.Auth)
+	if err != nil {
+		if _, ok := err.(*usersv2.DeleteUserNotFound); ok {
+			return
+		}
+		handleError("Delete User", &resp.Diagnostics, err)
+	}
+}
+
+// applyPayload copies the API-managed fields of a User into the model.
+func (m *UserResourceModel) applyPayload(payload *modelsv2.User) {
+	m.Token = types.StringValue(payload.Token)
+	m.Id = types.StringValue(payload.Token)
+	m.Role = types.StringValue(payload.Role)
+	m.Name = types.StringPointerValue(payload.Name)
+	m.Status = types.StringValue(userInvitationStatus(payload))
+}
+
+// userEmail returns the email to store for a User. The API may normalize the
+// address, so the prior value is kept when it only differs in case or
+// surrounding whitespace; email forces replacement, so reporting the
+// normalized address would re-invite the User on every plan.
+func userEmail(prior types.String, current string) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() && strings.EqualFold(strings.TrimSpace(prior.ValueString()), strings.TrimSpace(current)) {
+		return prior
+	}
+	return types.StringValue(current)
+}
+
+// userInvitationStatus derives the invitation status of a User. The API
+// reports an invited User without a last sign-in until they accept.
+func userInvitationStatus(payload *modelsv2.User) string {
+	if payload.LastSeenAt == nil || *payload.LastSeenAt == "" {
+		return "pending"
+	}
+	return "accepted"
+}
@@ -0,0 +1,77 @@
+package vantage
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/vantage-sh/terraform-provider-vantage/vantage/acctest"
+)
+
+func TestAccUserResource_basic(t *testing.T) {
+	resourceName := "vantage_user.test"
+	email := fmt.Sprintf("tf-acc-%s@example.com", strings.ToLower(sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlphaNum)))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Invite with the default role; the invitation is pending.
+			{
+				Config: testAccUserConfig(email, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "email", email),
+					resource.TestCheckResourceAttr(resourceName, "role", "editor"),
+					resource.TestCheckResourceAttr(resourceName, "status", "pending"),
+					resource.TestCheckResourceAttrSet(resourceName, "token"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "token"),
+				),
+			},
+			// Step 2: Change the role in place.
+			{
+				Config: testAccUserConfig(email, "viewer"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "role", "viewer"),
+				),
+			},
+			// Step 3: Import by token.
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccUserConfig(email, role string) string {
+	if role == "" {
+		return fmt.Sprintf(`
+resource "vantage_user" "test" {
+  email = %[1]q
+}
+`, email)
+	}
+	return fmt.Sprintf(`
+resource "vantage_user" "test" {
+  email = %[1]q
+  role  = %[2]q
+}
+`, email, role)
+}
+
+func TestUserEmail(t *testing.T) {
+	prior := types.StringValue("Jane.Doe@Example.com")
+	if got := userEmail(prior, "jane.doe@example.com"); !got.Equal(prior) {
+		t.Errorf("expected the prior email to be kept, got %v", got)
+	}
+	if got := userEmail(prior, "john@example.com"); got.ValueString() != "john@example.com" {
+		t.Errorf("expected the API's email, got %v", got)
+	}
+	if got := userEmail(types.StringNull(), "jane.doe@example.com"); got.ValueString() != "jane.doe@example.com" {
+		t.Errorf("expected the API's email on import, got %v", got)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	teamsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/teams"
 	usersv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/users"
 )
 
@@ -30,7 +32,9 @@ type userDataSourceModel struct {
 }
 
 type usersDataSourceModel struct {
-	Users []userDataSourceModel `tfsdk:"users"`
+	WorkspaceToken types.String          `tfsdk:"workspace_token"`
+	NameRegex      types.String          `tfsdk:"name_regex"`
+	Users          []userDataSourceModel `tfsdk:"users"`
 }
 
 func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -40,6 +44,14 @@ func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataReq
 func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"workspace_token": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return Users on a Team with access to this workspace. Users have no folder, creator or creation date, so the other filters of the plural data sources don't apply.",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return Users whose name or email matches this regular expression (RE2 syntax).",
+			},
 			"users": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -67,8 +79,28 @@ func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	var state usersDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
-	params := usersv2.NewGetUsersParams()
-	out, err := d.client.V2.Users.GetUsers(params, d.client.Auth)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := parseListFilter(listFilterConfig{
+		WorkspaceToken: state.WorkspaceToken,
+		TitleRegex:     state.NameRegex,
+	}, "name_regex", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all, err := fetchAllPages(func(limit int32, page *int32) ([]*modelsv2.User, *modelsv2.Links, error) {
+		params := usersv2.NewGetUsersParams()
+		params.SetLimit(&limit)
+		params.SetPage(page)
+		out, err := d.client.V2.Users.GetUsers(params, d.client.Auth)
+		if err != nil {
+			return nil, nil, err
+		}
+		return out.Payload.Users, out.Payload.Links, nil
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Get Vantage Users",
@@ -77,9 +109,30 @@ func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	// Users have no workspace of their own; they reach workspaces through
+	// their Teams.
+	var workspaces map[string][]string
+	if filter.WorkspaceToken != "" {
+		workspaces, err = userWorkspaceTokens(d.client)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Get Vantage Teams",
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	users := []userDataSourceModel{}
 
-	for _, u := range out.Payload.Users {
+	for _, u := range all {
+		titles := []string{u.Email}
+		if u.Name != nil {
+			titles = append(titles, *u.Name)
+		}
+		if !filter.Matches(listFilterItem{WorkspaceTokens: workspaces[u.Token], Titles: titles}) {
+			continue
+		}
 		users = append(users, userDataSourceModel{
 			Email: types.StringValue(u.Email),
 			Token: types.StringValue(u.Token),
@@ -102,3 +155,29 @@ func (d *usersDataSource) Configure(ctx context.Context, req datasource.Configur
 
 	d.client = req.ProviderData.(*Client)
 }
+
+// userWorkspaceTokens returns the workspaces each User can access through
+// their Teams, by User token.
+func userWorkspaceTokens(client *Client) (map[string][]string, error) {
+	teams, err := fetchAllPages(func(limit int32, page *int32) ([]*modelsv2.Team, *modelsv2.Links, error) {
+		params := teamsv2.NewGetTeamsParams()
+		params.SetLimit(&limit)
+		params.SetPage(page)
+		out, err := client.V2.Teams.GetTeams(params, client.Auth)
+		if err != nil {
+			return nil, nil, err
+		}
+		return out.Payload.Teams, out.Payload.Links, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces := map[string][]string{}
+	for _, team := range teams {
+		for _, user := range team.UserTokens {
+			workspaces[user] = append(workspaces[user], team.WorkspaceTokens...)
+		}
+	}
+	return workspaces, nil
+}
@@ -3,9 +3,12 @@ package vantage
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -15,15 +18,50 @@ import (
 )
 
 var (
-	_ resource.Resource                = (*VirtualTagConfigResource)(nil)
-	_ resource.ResourceWithConfigure   = (*VirtualTagConfigResource)(nil)
-	_ resource.ResourceWithImportState = (*VirtualTagConfigResource)(nil)
+	_ resource.Resource                   = (*VirtualTagConfigResource)(nil)
+	_ resource.ResourceWithConfigure      = (*VirtualTagConfigResource)(nil)
+	_ resource.ResourceWithImportState    = (*VirtualTagConfigResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*VirtualTagConfigResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*VirtualTagConfigResource)(nil)
 )
 
 type VirtualTagConfigResource struct {
 	client *Client
 }
 
+// virtualTagConfigResourceModel adds the resource-only manage_values and
+// values_source attributes to the generated model.
+type virtualTagConfigResourceModel struct {
+	resource_virtual_tag_config.VirtualTagConfigModel
+	ManageValues types.Bool   `tfsdk:"manage_values"`
+	ValuesSource types.Object `tfsdk:"values_source"`
+}
+
+// config returns the shared model for the conversion helpers.
+func (m *virtualTagConfigResourceModel) config() *virtualTagConfigModel {
+	return (*virtualTagConfigModel)(&m.VirtualTagConfigModel)
+}
+
+// managesValues reports whether values is owned by this resource rather than
+// by vantage_virtual_tag_config_value resources. Imported state has no
+// manage_values yet and defaults to true.
+func (m *virtualTagConfigResourceModel) managesValues() bool {
+	return m.ManageValues.IsNull() || m.ManageValues.IsUnknown() || m.ManageValues.ValueBool()
+}
+
+// applyPayload updates the model from payload, leaving values null when they
+// are managed by vantage_virtual_tag_config_value resources.
+func (m *virtualTagConfigResourceModel) applyPayload(ctx context.Context, payload *modelsv2.VirtualTagConfig) diag.Diagnostics {
+	diags := m.config().applyPayload(ctx, payload)
+	if m.ManageValues.IsNull() || m.ManageValues.IsUnknown() {
+		m.ManageValues = types.BoolValue(true)
+	}
+	if !m.managesValues() {
+		m.Values = types.ListNull(types.ObjectType{AttrTypes: resource_virtual_tag_config.ValuesValue{}.AttributeTypes(ctx)})
+	}
+	return diags
+}
+
 func NewVirtualTagConfigResource() resource.Resource {
 	return &VirtualTagConfigResource{}
 }
@@ -115,16 +153,112 @@ func (r VirtualTagConfigResource) Schema(ctx context.Context, req resource.Schem
 			},
 		},
 	}
+
+	resp.Schema.Attributes["manage_values"] = schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(true),
+		MarkdownDescription: "Whether this resource manages `values`. Set to `false` to manage the values with `vantage_virtual_tag_config_value` resources instead; `values` must then be left unset and is not read or changed by this resource. Defaults to `true`.",
+	}
+	resp.Schema.Attributes["values_source"] = virtualTagValuesSourceAttribute()
+}
+
+func (r VirtualTagConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var manageValues types.Bool
+	var values types.List
+	var valuesSource types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("manage_values"), &manageValues)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("values"), &values)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("values_source"), &valuesSource)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !manageValues.IsNull() && !manageValues.IsUnknown() && !manageValues.ValueBool() {
+		for name, v := range map[string]attr.Value{"values": values, "values_source": valuesSource} {
+			if !v.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Conflicting Virtual Tag Values",
+					name+" cannot be set when manage_values is false. Manage the values with vantage_virtual_tag_config_value resources instead.",
+				)
+			}
+		}
+	}
+	if !values.IsNull() && !valuesSource.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("values_source"),
+			"Conflicting Virtual Tag Values",
+			"values and values_source cannot both be set.",
+		)
+	}
+
+	validateVirtualTagValuesSource(ctx, valuesSource, &resp.Diagnostics)
+}
+
+// ModifyPlan keeps values null when they are managed by
+// vantage_virtual_tag_config_value resources, so changes made by those
+// resources don't show as a diff here, and expands values_source into the
+// planned values.
+func (r VirtualTagConfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var manageValues types.Bool
+	var valuesSource types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("manage_values"), &manageValues)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("values_source"), &valuesSource)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	valuesPath := path.Root("values")
+	elemType := types.ObjectType{AttrTypes: resource_virtual_tag_config.ValuesValue{}.AttributeTypes(ctx)}
+	if !manageValues.IsUnknown() && !manageValues.IsNull() && !manageValues.ValueBool() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, valuesPath, types.ListNull(elemType))...)
+		return
+	}
+	if valuesSource.IsNull() {
+		return
+	}
+
+	rows, known := validateVirtualTagValuesSource(ctx, valuesSource, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !known {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, valuesPath, types.ListUnknown(elemType))...)
+		return
+	}
+
+	var stateValues []*virtualTagConfigValueModel
+	if !req.State.Raw.IsNull() {
+		var state virtualTagConfigResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if state.managesValues() && !state.Values.IsNull() && !state.Values.IsUnknown() {
+			stateValues = state.config().valuesFromTf(ctx, &resp.Diagnostics)
+		}
+	}
+
+	values := virtualTagSourceValues(ctx, rows, stateValues, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, valuesPath, values)...)
 }
 
 func (r VirtualTagConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data *virtualTagConfigModel
+	var data *virtualTagConfigResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	model := data.toCreate(ctx, &resp.Diagnostics)
+	model := data.config().toCreate(ctx, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -151,7 +285,7 @@ func (r VirtualTagConfigResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r VirtualTagConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state *virtualTagConfigModel
+	var state *virtualTagConfigResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -184,30 +318,36 @@ func (r VirtualTagConfigResource) ImportState(ctx context.Context, req resource.
 }
 
 func (r VirtualTagConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data *virtualTagConfigModel
+	var data *virtualTagConfigResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var state *virtualTagConfigModel
+	var state *virtualTagConfigResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	changes := virtualTagConfigValueChanges{requiresParentUpdate: data.Values.IsNull() || data.Values.IsUnknown()}
-	if !changes.requiresParentUpdate {
-		planValues := data.valuesFromTf(ctx, &resp.Diagnostics)
-		stateValues := state.valuesFromTf(ctx, &resp.Diagnostics)
+	// Values managed by vantage_virtual_tag_config_value resources are left
+	// alone: values is null in the plan, so no values are sent. When this
+	// resource takes the values back, state has none to diff against and the
+	// planned list replaces them.
+	changes := virtualTagConfigValueChanges{requiresParentUpdate: data.Values.IsNull() || data.Values.IsUnknown() || !state.managesValues()}
+	if !data.managesValues() {
+		changes.requiresParentUpdate = false
+	} else if !changes.requiresParentUpdate {
+		planValues := data.config().valuesFromTf(ctx, &resp.Diagnostics)
+		stateValues := state.config().valuesFromTf(ctx, &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 		changes = diffVirtualTagConfigValues(planValues, stateValues)
 	}
 
-	if !data.parentFieldsEqual(state) || changes.requiresParentUpdate {
-		model := data.toUpdate(ctx, &resp.Diagnostics)
+	if !data.config().parentFieldsEqual(state.config()) || changes.requiresParentUpdate {
+		model := data.config().toUpdate(ctx, &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -289,11 +429,16 @@ func (r VirtualTagConfigResource) Update(ctx context.Context, req resource.Updat
 			return
 		}
 	}
+	if !data.managesValues() {
+		// Only manage_values changed.
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
 	refreshState()
 }
 
 func (r VirtualTagConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state *virtualTagConfigModel
+	var state *virtualTagConfigResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -0,0 +1,345 @@
+package vantage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	tagsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/virtual_tags"
+)
+
+var (
+	_ resource.Resource                = (*VirtualTagConfigValueResource)(nil)
+	_ resource.ResourceWithConfigure   = (*VirtualTagConfigValueResource)(nil)
+	_ resource.ResourceWithImportState = (*VirtualTagConfigValueResource)(nil)
+)
+
+// virtualTagConfigValueLocks serializes changes to the values of a virtual
+// tag config within the provider, so that parallel writes don't race on the
+// config's list of values. It doesn't order them: values created in parallel
+// are created in whatever order Terraform schedules them, which is why
+// position is read back separately from the configured priority.
+var virtualTagConfigValueLocks = &keyedMutex{locks: map[string]*sync.Mutex{}}
+
+type VirtualTagConfigValueResource struct {
+	client *Client
+}
+
+func NewVirtualTagConfigValueResource() resource.Resource {
+	return &VirtualTagConfigValueResource{}
+}
+
+type virtualTagConfigValueResourceModel struct {
+	virtualTagConfigValueModel
+	ConfigToken types.String `tfsdk:"config_token"`
+	Priority    types.Int64  `tfsdk:"priority"`
+	Position    types.Int64  `tfsdk:"position"`
+	Id          types.String `tfsdk:"id"`
+}
+
+func (r *VirtualTagConfigValueResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *VirtualTagConfigValueResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_tag_config_value"
+}
+
+func (r *VirtualTagConfigValueResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// The value attributes are the same as an entry of values on
+	// vantage_virtual_tag_config.
+	var parent resource.SchemaResponse
+	VirtualTagConfigResource{}.Schema(ctx, req, &parent)
+	valueAttrs := parent.Schema.Attributes["values"].(schema.ListNestedAttribute).NestedObject.Attributes
+
+	attrs := make(map[string]schema.Attribute, len(valueAttrs)+5)
+	for name, attr := range valueAttrs {
+		attrs[name] = attr
+	}
+	attrs["token"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The token of the Value.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attrs["config_token"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The token of the VirtualTagConfig the Value belongs to. The VirtualTagConfig should set `manage_values = false`.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attrs["priority"] = schema.Int64Attribute{
+		Optional:            true,
+		MarkdownDescription: "The position to create or move the Value to among the VirtualTagConfig's values, starting at 1. Values are matched in order, so a lower priority wins. When unset, the Value is added after the existing values. Values created in parallel and changes to other values can move the Value, so its current position is reported in `position`, with a warning when it differs from `priority`.",
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+	attrs["position"] = schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The current position of the Value among the VirtualTagConfig's values, starting at 1.",
+	}
+	attrs["id"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The id of the Value, in the form `<config_token>/<token>`.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a single Value of a VirtualTagConfig. Use it with `manage_values = false` on `vantage_virtual_tag_config` to manage large virtual tags value by value.",
+		Attributes:          attrs,
+	}
+}
+
+func (r *VirtualTagConfigValueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	configToken, token, ok := strings.Cut(req.ID, "/")
+	if !ok || configToken == "" || token == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import id of the form <config_token>/<value_token>, got %q.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(req.ID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("config_token"), types.StringValue(configToken))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("token"), types.StringValue(token))...)
+}
+
+func (r *VirtualTagConfigValueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data virtualTagConfigValueResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model := data.toCreateValue(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model.Priority = data.priority()
+
+	configToken := data.ConfigToken.ValueString()
+	virtualTagConfigValueLocks.Lock(configToken)
+	defer virtualTagConfigValueLocks.Unlock(configToken)
+
+	params := tagsv2.
+		NewCreateVirtualTagConfigValueParams().
+		WithVirtualTagConfigToken(configToken).
+		WithCreateVirtualTagConfigValue(model)
+	out, err := r.client.V2.VirtualTags.CreateVirtualTagConfigValue(params, r.client.Auth)
+	if err != nil {
+		if e, ok := err.(*tagsv2.CreateVirtualTagConfigValueBadRequest); ok {
+			handleBadRequest("Create Virtual Tag Config Value", &resp.Diagnostics, e.GetPayload())
+			return
+		}
+		handleError("Create Virtual Tag Config Value", &resp.Diagnostics, err)
+		return
+	}
+	data.Token = types.StringValue(out.Payload.Token)
+
+	if !r.refresh(ctx, &data, "Create Virtual Tag Config Value", &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtualTagConfigValueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state virtualTagConfigValueResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.getConfig(state.ConfigToken.ValueString())
+	if err != nil {
+		if _, ok := err.(*tagsv2.GetVirtualTagConfigNotFound); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		handleError("Read Virtual Tag Config Value", &resp.Diagnostics, err)
+		return
+	}
+
+	found, diags := state.applyConfigPayload(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.warnPriorityDrift(&resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *VirtualTagConfigValueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data virtualTagConfigValueResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model := data.toUpdateValue(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model.Priority = data.priority()
+
+	configToken := data.ConfigToken.ValueString()
+	virtualTagConfigValueLocks.Lock(configToken)
+	defer virtualTagConfigValueLocks.Unlock(configToken)
+
+	params := tagsv2.
+		NewUpdateVirtualTagConfigValueParams().
+		WithVirtualTagConfigToken(configToken).
+		WithVirtualTagConfigValueToken(data.Token.ValueString()).
+		WithUpdateVirtualTagConfigValue(model)
+	if _, err := r.client.V2.VirtualTags.UpdateVirtualTagConfigValue(params, r.client.Auth); err != nil {
+		if e, ok := err.(*tagsv2.UpdateVirtualTagConfigValueBadRequest); ok {
+			handleBadRequest("Update Virtual Tag Config Value", &resp.Diagnostics, e.GetPayload())
+			return
+		}
+		handleError("Update Virtual Tag Config Value", &resp.Diagnostics, err)
+		return
+	}
+
+	if !r.refresh(ctx, &data, "Update Virtual Tag Config Value", &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtualTagConfigValueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state virtualTagConfigValueResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configToken := state.ConfigToken.ValueString()
+	virtualTagConfigValueLocks.Lock(configToken)
+	defer virtualTagConfigValueLocks.Unlock(configToken)
+
+	params := tagsv2.
+		NewDeleteVirtualTagConfigValueParams().
+		WithVirtualTagConfigToken(configToken).
+		WithVirtualTagConfigValueToken(state.Token.ValueString())
+	if _, err := r.client.V2.VirtualTags.DeleteVirtualTagConfigValue(params, r.client.Auth); err != nil {
+		if _, ok := err.(*tagsv2.DeleteVirtualTagConfigValueNotFound); ok {
+			return
+		}
+		handleError("Delete Virtual Tag Config Value", &resp.Diagnostics, err)
+	}
+}
+
+func (r *VirtualTagConfigValueResource) getConfig(token string) (*modelsv2.VirtualTagConfig, error) {
+	params := tagsv2.NewGetVirtualTagConfigParams().WithToken(token)
+	out, err := r.client.V2.VirtualTags.GetVirtualTagConfig(params, r.client.Auth)
+	if err != nil {
+		return nil, err
+	}
+	return out.Payload, nil
+}
+
+// refresh reads the Value back after a write, since its position depends on
+// the other values of the config. It reports whether data was updated.
+func (r *VirtualTagConfigValueResource) refresh(ctx context.Context, data *virtualTagConfigValueResourceModel, action string, diags *diag.Diagnostics) bool {
+	config, err := r.getConfig(data.ConfigToken.ValueString())
+	if err != nil {
+		handleError(action, diags, err)
+		return false
+	}
+
+	found, d := data.applyConfigPayload(ctx, config)
+	diags.Append(d...)
+	if diags.HasError() {
+		return false
+	}
+	if !found {
+		diags.AddError(action, fmt.Sprintf("Value %s was not found on VirtualTagConfig %s after the change.", data.Token.ValueString(), data.ConfigToken.ValueString()))
+		return false
+	}
+	data.warnPriorityDrift(diags)
+	return true
+}
+
+// priority returns the configured priority for a create or update payload,
+// or nil to leave the Value's position to the API.
+func (m *virtualTagConfigValueResourceModel) priority() *int32 {
+	if m.Priority.IsNull() || m.Priority.IsUnknown() {
+		return nil
+	}
+	p := int32(m.Priority.ValueInt64())
+	return &p
+}
+
+// applyConfigPayload updates the model from the Value with its token in
+// config and reports whether the Value was found.
+func (m *virtualTagConfigValueResourceModel) applyConfigPayload(ctx context.Context, config *modelsv2.VirtualTagConfig) (bool, diag.Diagnostics) {
+	index := virtualTagConfigValueIndex(config, m.Token.ValueString())
+	if index < 0 {
+		return false, nil
+	}
+
+	obj, diags := buildValueFromPayload(ctx, config.Values[index])
+	if diags.HasError() {
+		return false, diags
+	}
+	diags.Append(obj.As(ctx, &m.virtualTagConfigValueModel, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	m.ConfigToken = types.StringValue(config.Token)
+	m.Position = types.Int64Value(int64(index + 1))
+	m.Id = types.StringValue(config.Token + "/" + m.Token.ValueString())
+	return true, diags
+}
+
+// warnPriorityDrift warns when the Value isn't at its configured priority,
+// e.g. because values were created in parallel or an earlier value was
+// deleted. The configured priority is kept in state, so the position is only
+// reported and not planned as a change.
+func (m *virtualTagConfigValueResourceModel) warnPriorityDrift(diags *diag.Diagnostics) {
+	if m.Priority.IsNull() || m.Priority.IsUnknown() || m.Priority.Equal(m.Position) {
+		return
+	}
+	diags.AddAttributeWarning(
+		path.Root("priority"),
+		"Virtual Tag Config Value Priority Drift",
+		fmt.Sprintf("Value %s of VirtualTagConfig %s is configured with priority %d but is at position %d. Other values were created, moved or deleted around it; change priority or set depends_on between the values to order them.",
+			m.Token.ValueString(), m.ConfigToken.ValueString(), m.Priority.ValueInt64(), m.Position.ValueInt64()),
+	)
+}
+
+// virtualTagConfigValueIndex returns the index of the Value with the given
+// token among the config's values, or -1 if there is none.
+func virtualTagConfigValueIndex(config *modelsv2.VirtualTagConfig, token string) int {
+	for i, v := range config.Values {
+		if v.Token == token {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,99 @@
+package vantage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+)
+
+func TestVirtualTagConfigValueApplyConfigPayload(t *testing.T) {
+	ctx := context.Background()
+	first, second := "Platform", "Data"
+	filter := "costs.provider = 'aws'"
+	config := &modelsv2.VirtualTagConfig{
+		Token: "vtag_team",
+		Values: []*modelsv2.VirtualTagConfigValue{
+			{Token: "vtag_val_platform", Name: &first, Filter: &filter},
+			{Token: "vtag_val_data", Name: &second, Filter: &filter},
+		},
+	}
+
+	var m virtualTagConfigValueResourceModel
+	m.Token = types.StringValue("vtag_val_data")
+	found, diags := m.applyConfigPayload(ctx, config)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !found {
+		t.Fatal("expected the value to be found")
+	}
+	if m.Position.ValueInt64() != 2 || !m.Priority.IsNull() {
+		t.Errorf("expected position 2 and no priority, got %d and %s", m.Position.ValueInt64(), m.Priority)
+	}
+	if m.Name.ValueString() != "Data" || m.Filter.ValueString() != filter {
+		t.Errorf("unexpected value attributes name=%s filter=%s", m.Name, m.Filter)
+	}
+	if m.Id.ValueString() != "vtag_team/vtag_val_data" || m.ConfigToken.ValueString() != "vtag_team" {
+		t.Errorf("unexpected id %s or config token %s", m.Id, m.ConfigToken)
+	}
+
+	m.Token = types.StringValue("vtag_val_deleted")
+	if found, _ := m.applyConfigPayload(ctx, config); found {
+		t.Error("expected a deleted value not to be found")
+	}
+}
+
+func TestVirtualTagConfigValuePriorityDrift(t *testing.T) {
+	ctx := context.Background()
+	filter := "costs.provider = 'aws'"
+	value := func(token string) *modelsv2.VirtualTagConfigValue {
+		return &modelsv2.VirtualTagConfigValue{Token: token, Filter: &filter}
+	}
+
+	// A value configured with priority 3 that was created first, before the
+	// values planned ahead of it.
+	var m virtualTagConfigValueResourceModel
+	m.Token = types.StringValue("vtag_val_c")
+	m.Priority = types.Int64Value(3)
+	config := &modelsv2.VirtualTagConfig{Token: "vtag_team", Values: []*modelsv2.VirtualTagConfigValue{value("vtag_val_c")}}
+	if found, diags := m.applyConfigPayload(ctx, config); !found || diags.HasError() {
+		t.Fatalf("expected the value to be found, got %v", diags)
+	}
+	var diags diag.Diagnostics
+	m.warnPriorityDrift(&diags)
+	if m.Priority.ValueInt64() != 3 || m.Position.ValueInt64() != 1 {
+		t.Errorf("expected priority 3 at position 1, got %s at %s", m.Priority, m.Position)
+	}
+	if diags.WarningsCount() != 1 || diags.HasError() {
+		t.Errorf("expected a single warning, got %v", diags)
+	}
+
+	// Once the other values are created it is at its priority.
+	config.Values = []*modelsv2.VirtualTagConfigValue{value("vtag_val_a"), value("vtag_val_b"), value("vtag_val_c")}
+	m.applyConfigPayload(ctx, config)
+	diags = nil
+	m.warnPriorityDrift(&diags)
+	if m.Position.ValueInt64() != 3 || len(diags) != 0 {
+		t.Errorf("expected position 3 without warnings, got %s and %v", m.Position, diags)
+	}
+
+	// Deleting an earlier value moves it up without changing its priority.
+	config.Values = []*modelsv2.VirtualTagConfigValue{value("vtag_val_b"), value("vtag_val_c")}
+	m.applyConfigPayload(ctx, config)
+	diags = nil
+	m.warnPriorityDrift(&diags)
+	if m.Priority.ValueInt64() != 3 || m.Position.ValueInt64() != 2 || diags.WarningsCount() != 1 {
+		t.Errorf("expected priority 3 at position 2 with a warning, got %s at %s and %v", m.Priority, m.Position, diags)
+	}
+
+	// A value without a configured priority never warns.
+	m.Priority = types.Int64Null()
+	diags = nil
+	m.warnPriorityDrift(&diags)
+	if len(diags) != 0 {
+		t.Errorf("expected no warnings, got %v", diags)
+	}
+}
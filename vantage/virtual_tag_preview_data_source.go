@@ -0,0 +1,420 @@
+package vantage
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+	costsv2 "github.com/vantage-sh/vantage-go/vantagev2/vantage/costs"
+)
+
+var (
+	_ datasource.DataSource              = (*virtualTagPreviewDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*virtualTagPreviewDataSource)(nil)
+)
+
+var virtualTagPreviewDateRegexp = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+// virtualTagPreviewMaxValues caps the values of a preview. Each value costs a
+// paginated cost query whose filter ORs it with every earlier value, so a
+// preview of a large VirtualTagConfig would run on every plan for minutes.
+const virtualTagPreviewMaxValues = 50
+
+func NewVirtualTagPreviewDataSource() datasource.DataSource {
+	return &virtualTagPreviewDataSource{}
+}
+
+type virtualTagPreviewDataSource struct {
+	client *Client
+}
+
+type virtualTagPreviewDataSourceModel struct {
+	Key                types.String                         `tfsdk:"key"`
+	Filter             types.String                         `tfsdk:"filter"`
+	StartDate          types.String                         `tfsdk:"start_date"`
+	EndDate            types.String                         `tfsdk:"end_date"`
+	WorkspaceToken     types.String                         `tfsdk:"workspace_token"`
+	Values             []virtualTagPreviewValueModel        `tfsdk:"values"`
+	CollapsedTagKeys   []virtualTagPreviewCollapsedKeyModel `tfsdk:"collapsed_tag_keys"`
+	TotalAmount        types.Float64                        `tfsdk:"total_amount"`
+	MatchedAmount      types.Float64                        `tfsdk:"matched_amount"`
+	UnmatchedAmount    types.Float64                        `tfsdk:"unmatched_amount"`
+	CoveragePercentage types.Float64                        `tfsdk:"coverage_percentage"`
+	ValueCosts         []virtualTagPreviewValueCostModel    `tfsdk:"value_costs"`
+}
+
+type virtualTagPreviewValueModel struct {
+	Filter              types.String                           `tfsdk:"filter"`
+	Name                types.String                           `tfsdk:"name"`
+	DisplayName         types.String                           `tfsdk:"display_name"`
+	BusinessMetricToken types.String                           `tfsdk:"business_metric_token"`
+	LabelKey            types.String                           `tfsdk:"label_key"`
+	LabelValues         types.List                             `tfsdk:"label_values"`
+	LabelTransforms     []virtualTagPreviewLabelTransformModel `tfsdk:"label_transforms"`
+	Percentages         []virtualTagPreviewPercentageModel     `tfsdk:"percentages"`
+}
+
+type virtualTagPreviewLabelTransformModel struct {
+	Type      types.String `tfsdk:"type"`
+	Delimiter types.String `tfsdk:"delimiter"`
+	Index     types.Int64  `tfsdk:"index"`
+	Template  types.String `tfsdk:"template"`
+}
+
+type virtualTagPreviewPercentageModel struct {
+	Value types.String  `tfsdk:"value"`
+	Pct   types.Float64 `tfsdk:"pct"`
+}
+
+type virtualTagPreviewCollapsedKeyModel struct {
+	Key       types.String `tfsdk:"key"`
+	Filter    types.String `tfsdk:"filter"`
+	Providers types.List   `tfsdk:"providers"`
+}
+
+type virtualTagPreviewValueCostModel struct {
+	Label       types.String                       `tfsdk:"label"`
+	Filter      types.String                       `tfsdk:"filter"`
+	Amount      types.Float64                      `tfsdk:"amount"`
+	Allocations []virtualTagPreviewAllocationModel `tfsdk:"allocations"`
+}
+
+type virtualTagPreviewAllocationModel struct {
+	Value  types.String  `tfsdk:"value"`
+	Amount types.Float64 `tfsdk:"amount"`
+}
+
+func (d *virtualTagPreviewDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*Client)
+}
+
+func (d *virtualTagPreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_tag_preview"
+}
+
+func (d *virtualTagPreviewDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	dateValidators := []validator.String{
+		stringvalidator.RegexMatches(virtualTagPreviewDateRegexp, "must be formatted YYYY-MM-DD"),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Previews the costs a VirtualTagConfig would match without creating it. Takes the same `key`, `values` and `collapsed_tag_keys` as `vantage_virtual_tag_config` and returns the cost matched by each value, in order of precedence, and the unmatched remainder of the costs in `filter` over a date range.",
+		Attributes: map[string]schema.Attribute{
+			"key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The key of the VirtualTagConfig being previewed.",
+			},
+			"filter": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The VQL filter for the costs to preview against, e.g. `costs.provider = 'aws'`. The unmatched remainder is the part of these costs no value matches.",
+			},
+			"start_date": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The first date of the preview, formatted `YYYY-MM-DD`.",
+				Validators:          dateValidators,
+			},
+			"end_date": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The last date of the preview, formatted `YYYY-MM-DD`.",
+				Validators:          dateValidators,
+			},
+			"workspace_token": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The token of the Workspace to query costs in. Defaults to the default Workspace.",
+			},
+			"values": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: fmt.Sprintf("The values of the VirtualTagConfig, with match precedence determined by order in the list. At most %d values can be previewed.", virtualTagPreviewMaxValues),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(virtualTagPreviewMaxValues),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"filter": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The filter query language to apply to the value.",
+						},
+						"name": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The name of the value.",
+						},
+						"display_name": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The display name for an allocation value.",
+						},
+						"business_metric_token": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The token of an associated business metric.",
+						},
+						"label_key": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The business metric label key used for this value.",
+						},
+						"label_values": schema.ListAttribute{
+							Optional:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Business metric label values.",
+						},
+						"label_transforms": schema.ListNestedAttribute{
+							Optional:            true,
+							MarkdownDescription: "Label transforms applied to business metric labels. Accepted so a value can be copied from `vantage_virtual_tag_config`; they don't change which costs match.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "The label transform type.",
+									},
+									"delimiter": schema.StringAttribute{
+										Optional:            true,
+										MarkdownDescription: "Delimiter used by split transforms.",
+									},
+									"index": schema.Int64Attribute{
+										Optional:            true,
+										MarkdownDescription: "Zero-based index used by split transforms.",
+									},
+									"template": schema.StringAttribute{
+										Optional:            true,
+										MarkdownDescription: "Template used by format transforms.",
+									},
+								},
+							},
+						},
+						"percentages": schema.ListNestedAttribute{
+							Optional:            true,
+							MarkdownDescription: "Labeled percentage allocations for matching costs. The matched amount is split between them in `value_costs.allocations`.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"value": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "The tag value associated with a percentage of matched costs.",
+									},
+									"pct": schema.Float64Attribute{
+										Required:            true,
+										MarkdownDescription: "The percentage of matched costs.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"collapsed_tag_keys": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Tag keys to collapse values for. Accepted so a configuration can be copied from `vantage_virtual_tag_config`; they don't change which costs match.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The tag key to collapse values for.",
+						},
+						"filter": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The VQL filter this collapsed tag key applies to.",
+						},
+						"providers": schema.ListAttribute{
+							Optional:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Provider-only scope for this collapsed tag key.",
+						},
+					},
+				},
+			},
+			"total_amount": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The total cost in `filter` over the date range.",
+			},
+			"matched_amount": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The cost matched by any value.",
+			},
+			"unmatched_amount": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The cost in `filter` that no value matches.",
+			},
+			"coverage_percentage": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "`matched_amount` as a percentage of `total_amount`. Null when `total_amount` is 0.",
+			},
+			"value_costs": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The cost matched by each value, in the order of `values`. A cost matched by an earlier value is not counted again.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"label": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The value's `name`, `display_name` or `business_metric_token`, whichever is set.",
+						},
+						"filter": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The value's filter.",
+						},
+						"amount": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The cost matched by the value.",
+						},
+						"allocations": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "For a value with `percentages`, the matched cost allocated to each percentage.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"value": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The tag value of the percentage.",
+									},
+									"amount": schema.Float64Attribute{
+										Computed:            true,
+										MarkdownDescription: "The cost allocated to the tag value.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *virtualTagPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state virtualTagPreviewDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := make([]string, 0, len(state.Values))
+	for _, v := range state.Values {
+		filters = append(filters, v.Filter.ValueString())
+	}
+
+	startDate := state.StartDate.ValueString()
+	endDate := state.EndDate.ValueString()
+	workspaceToken := state.WorkspaceToken.ValueStringPointer()
+	costTotal := func(filter string) (float64, error) {
+		costs, err := fetchAllPages(func(limit int32, page *int32) ([]*modelsv2.Cost, *modelsv2.Links, error) {
+			params := costsv2.NewGetCostsParams()
+			params.SetFilter(&filter)
+			params.SetStartDate(&startDate)
+			params.SetEndDate(&endDate)
+			params.SetWorkspaceToken(workspaceToken)
+			params.SetLimit(&limit)
+			params.SetPage(page)
+			out, err := d.client.V2.Costs.GetCosts(params, d.client.Auth)
+			if err != nil {
+				return nil, nil, err
+			}
+			return out.Payload.Costs, out.Payload.Links, nil
+		})
+		if err != nil {
+			return 0, err
+		}
+		var total float64
+		for _, c := range costs {
+			amount, err := strconv.ParseFloat(c.Amount, 64)
+			if err != nil {
+				return 0, fmt.Errorf("parsing cost amount %q: %w", c.Amount, err)
+			}
+			total += amount
+		}
+		return total, nil
+	}
+
+	preview, err := previewVirtualTag(state.Filter.ValueString(), filters, costTotal)
+	if err != nil {
+		handleError("Preview Virtual Tag", &resp.Diagnostics, err)
+		return
+	}
+
+	state.TotalAmount = types.Float64Value(preview.Total)
+	state.MatchedAmount = types.Float64Value(preview.Matched)
+	state.UnmatchedAmount = types.Float64Value(roundCents(preview.Total - preview.Matched))
+	state.CoveragePercentage = types.Float64Null()
+	if preview.Total != 0 {
+		state.CoveragePercentage = types.Float64Value(math.Round(preview.Matched/preview.Total*10000) / 100)
+	}
+
+	state.ValueCosts = make([]virtualTagPreviewValueCostModel, 0, len(state.Values))
+	for i, v := range state.Values {
+		amount := preview.ValueAmounts[i]
+		allocations := make([]virtualTagPreviewAllocationModel, 0, len(v.Percentages))
+		for _, p := range v.Percentages {
+			allocations = append(allocations, virtualTagPreviewAllocationModel{
+				Value:  p.Value,
+				Amount: types.Float64Value(roundCents(amount * p.Pct.ValueFloat64() / 100)),
+			})
+		}
+		state.ValueCosts = append(state.ValueCosts, virtualTagPreviewValueCostModel{
+			Label:       types.StringValue(v.label()),
+			Filter:      v.Filter,
+			Amount:      types.Float64Value(amount),
+			Allocations: allocations,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// label returns the name that identifies the value in value_costs.
+func (v virtualTagPreviewValueModel) label() string {
+	for _, s := range []types.String{v.Name, v.DisplayName, v.BusinessMetricToken} {
+		if s.ValueString() != "" {
+			return s.ValueString()
+		}
+	}
+	return ""
+}
+
+// virtualTagPreview is the result of previewVirtualTag. Amounts are rounded
+// to cents.
+type virtualTagPreview struct {
+	Total        float64
+	Matched      float64
+	ValueAmounts []float64
+}
+
+// previewVirtualTag computes the cost matched by each value filter within
+// base, honoring precedence: a cost matched by an earlier value isn't counted
+// for a later one. VQL has no negation, so each value's amount is the
+// difference between the cost matched by it or any earlier value and the cost
+// matched by the earlier values alone. costTotal returns the total cost for a
+// VQL filter.
+func previewVirtualTag(base string, filters []string, costTotal func(filter string) (float64, error)) (virtualTagPreview, error) {
+	total, err := costTotal(base)
+	if err != nil {
+		return virtualTagPreview{}, err
+	}
+
+	preview := virtualTagPreview{Total: roundCents(total), ValueAmounts: make([]float64, 0, len(filters))}
+	var previous float64
+	for i := range filters {
+		clauses := make([]string, 0, i+1)
+		for _, f := range filters[:i+1] {
+			clauses = append(clauses, "("+f+")")
+		}
+		cumulative, err := costTotal(fmt.Sprintf("(%s) AND (%s)", base, strings.Join(clauses, " OR ")))
+		if err != nil {
+			return virtualTagPreview{}, err
+		}
+		preview.ValueAmounts = append(preview.ValueAmounts, roundCents(cumulative-previous))
+		previous = cumulative
+	}
+	preview.Matched = roundCents(previous)
+	return preview, nil
+}
+
+func roundCents(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,48 @@
+package vantage
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPreviewVirtualTag(t *testing.T) {
+	costs := map[string]float64{
+		"costs.provider = 'aws'":                                                                 1000,
+		"(costs.provider = 'aws') AND ((costs.service = 'EC2'))":                                 600.006,
+		"(costs.provider = 'aws') AND ((costs.service = 'EC2') OR (costs.region = 'us-east-1'))": 750,
+	}
+	var queried []string
+	costTotal := func(filter string) (float64, error) {
+		queried = append(queried, filter)
+		v, ok := costs[filter]
+		if !ok {
+			t.Fatalf("unexpected filter %q", filter)
+		}
+		return v, nil
+	}
+
+	got, err := previewVirtualTag("costs.provider = 'aws'", []string{"costs.service = 'EC2'", "costs.region = 'us-east-1'"}, costTotal)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(queried) != 3 {
+		t.Errorf("expected 3 queries, got %q", queried)
+	}
+	if got.Total != 1000 || got.Matched != 750 {
+		t.Errorf("expected total 1000 and matched 750, got %+v", got)
+	}
+	// The region value only counts costs the EC2 value didn't match.
+	if len(got.ValueAmounts) != 2 || got.ValueAmounts[0] != 600.01 || got.ValueAmounts[1] != 149.99 {
+		t.Errorf("unexpected value amounts %v", got.ValueAmounts)
+	}
+}
+
+func TestPreviewVirtualTagError(t *testing.T) {
+	want := errors.New("boom")
+	_, err := previewVirtualTag("costs.provider = 'aws'", []string{"costs.service = 'EC2'"}, func(string) (float64, error) {
+		return 0, want
+	})
+	if !errors.Is(err, want) {
+		t.Errorf("expected %v, got %v", want, err)
+	}
+}
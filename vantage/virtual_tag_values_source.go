@@ -0,0 +1,327 @@
+package vantage
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/vantage-sh/terraform-provider-vantage/vantage/resource_virtual_tag_config"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+)
+
+// virtualTagValuesSourceColumns are the columns of a values_source, in the
+// order they are documented.
+var virtualTagValuesSourceColumns = []string{"filter", "account_id", "name", "business_metric_token", "display_name", "percentages"}
+
+type virtualTagValuesSourceModel struct {
+	Format  types.String `tfsdk:"format"`
+	Content types.String `tfsdk:"content"`
+}
+
+func virtualTagValuesSourceAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional: true,
+		MarkdownDescription: "Loads `values` from CSV or JSON content, such as a CMDB export read with `file()`, instead of listing them in HCL. " +
+			"Each row has a `filter` or an `account_id` (matched as `costs.account_id`), which is the row's key, and exactly one of `name`, `business_metric_token` or `percentages`. " +
+			"Rows are matched to existing values by key, so only added, changed and removed rows are sent to the API. Existing values keep their position and new rows are added after them. " +
+			"Conflicts with `values`.",
+		Attributes: map[string]schema.Attribute{
+			"format": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The format of `content`. One of `csv` or `json`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("csv", "json"),
+				},
+			},
+			"content": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The rows. CSV content needs a header row naming the columns `filter`, `account_id`, `name`, `business_metric_token`, `display_name` and `percentages`; only the columns used are required, and empty cells are unset. " +
+					"`percentages` is written as `value:pct` pairs separated by `;`, e.g. `platform:60;data:40`. " +
+					"JSON content is an array of objects with the same keys, where `percentages` is an array of `{\"value\", \"pct\"}` objects.",
+			},
+		},
+	}
+}
+
+// virtualTagSourceRow is one row of a values_source.
+type virtualTagSourceRow struct {
+	Filter              string                       `json:"filter"`
+	AccountID           string                       `json:"account_id"`
+	Name                string                       `json:"name"`
+	BusinessMetricToken string                       `json:"business_metric_token"`
+	DisplayName         string                       `json:"display_name"`
+	Percentages         []virtualTagSourcePercentage `json:"percentages"`
+}
+
+type virtualTagSourcePercentage struct {
+	Value string  `json:"value"`
+	Pct   float64 `json:"pct"`
+}
+
+// key returns the filter the row's value matches on, which identifies the
+// row across changes to the source.
+func (r virtualTagSourceRow) key() string {
+	if r.AccountID != "" {
+		return fmt.Sprintf("(costs.account_id = '%s')", strings.ReplaceAll(r.AccountID, "'", "\\'"))
+	}
+	return r.Filter
+}
+
+func (r virtualTagSourceRow) validate() error {
+	if (r.Filter == "") == (r.AccountID == "") {
+		return errors.New("exactly one of filter or account_id must be set")
+	}
+	set := 0
+	for _, ok := range []bool{r.Name != "", r.BusinessMetricToken != "", len(r.Percentages) > 0} {
+		if ok {
+			set++
+		}
+	}
+	if set != 1 {
+		return errors.New("exactly one of name, business_metric_token or percentages must be set")
+	}
+	if r.DisplayName != "" && len(r.Percentages) == 0 {
+		return errors.New("display_name can only be set with percentages")
+	}
+	for _, p := range r.Percentages {
+		if p.Value == "" {
+			return errors.New("every percentage needs a value")
+		}
+		if p.Pct < 0 || p.Pct > 100 {
+			return fmt.Errorf("percentage %q must be between 0 and 100, got %v", p.Value, p.Pct)
+		}
+	}
+	return nil
+}
+
+// parseVirtualTagValuesSource parses and validates the rows of a
+// values_source. Errors name the row they were found in, counting the CSV
+// header as row 1.
+func parseVirtualTagValuesSource(format, content string) ([]virtualTagSourceRow, error) {
+	var rows []virtualTagSourceRow
+	var err error
+	rowNumber := func(i int) int { return i + 1 }
+	switch format {
+	case "csv":
+		rows, err = parseVirtualTagValuesCSV(content)
+		rowNumber = func(i int) int { return i + 2 }
+	case "json":
+		err = json.Unmarshal([]byte(content), &rows)
+	default:
+		err = fmt.Errorf("unsupported format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]int, len(rows))
+	for i, row := range rows {
+		if err := row.validate(); err != nil {
+			return nil, fmt.Errorf("row %d: %w", rowNumber(i), err)
+		}
+		if prior, ok := seen[row.key()]; ok {
+			return nil, fmt.Errorf("row %d: duplicates the key %q of row %d", rowNumber(i), row.key(), rowNumber(prior))
+		}
+		seen[row.key()] = i
+	}
+	return rows, nil
+}
+
+func parseVirtualTagValuesCSV(content string) ([]virtualTagSourceRow, error) {
+	r := csv.NewReader(bytes.NewReader([]byte(content)))
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, errors.New("the CSV content is empty; a header row is required")
+		}
+		return nil, err
+	}
+	columns := make([]string, len(header))
+	for i, h := range header {
+		name := strings.ToLower(strings.TrimSpace(h))
+		if !slices.Contains(virtualTagValuesSourceColumns, name) {
+			return nil, fmt.Errorf("unknown column %q; expected some of %s", h, strings.Join(virtualTagValuesSourceColumns, ", "))
+		}
+		columns[i] = name
+	}
+
+	var rows []virtualTagSourceRow
+	for line := 2; ; line++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var row virtualTagSourceRow
+		for i, cell := range record {
+			cell = strings.TrimSpace(cell)
+			switch columns[i] {
+			case "filter":
+				row.Filter = cell
+			case "account_id":
+				row.AccountID = cell
+			case "name":
+				row.Name = cell
+			case "business_metric_token":
+				row.BusinessMetricToken = cell
+			case "display_name":
+				row.DisplayName = cell
+			case "percentages":
+				row.Percentages, err = parseVirtualTagSourcePercentages(cell)
+				if err != nil {
+					return nil, fmt.Errorf("row %d: %w", line, err)
+				}
+			}
+		}
+		rows = append(rows, row)
+	}
+}
+
+// parseVirtualTagSourcePercentages parses `value:pct` pairs separated by `;`.
+func parseVirtualTagSourcePercentages(cell string) ([]virtualTagSourcePercentage, error) {
+	if cell == "" {
+		return nil, nil
+	}
+	var percentages []virtualTagSourcePercentage
+	for _, pair := range strings.Split(cell, ";") {
+		value, pct, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok {
+			return nil, fmt.Errorf("percentage %q must be written as value:pct", pair)
+		}
+		n, err := strconv.ParseFloat(strings.TrimSpace(pct), 64)
+		if err != nil {
+			return nil, fmt.Errorf("percentage %q has an invalid pct: %w", pair, err)
+		}
+		percentages = append(percentages, virtualTagSourcePercentage{Value: strings.TrimSpace(value), Pct: n})
+	}
+	return percentages, nil
+}
+
+// virtualTagSourceValues builds the planned values for rows. Rows are matched
+// to the values in state by key: matched values keep their token and their
+// position, and new rows are appended in source order. Keeping positions
+// lets the update reconcile the values one by one instead of replacing the
+// list.
+func virtualTagSourceValues(ctx context.Context, rows []virtualTagSourceRow, state []*virtualTagConfigValueModel, diags *diag.Diagnostics) types.List {
+	elemType := types.ObjectType{AttrTypes: resource_virtual_tag_config.ValuesValue{}.AttributeTypes(ctx)}
+
+	byKey := make(map[string]virtualTagSourceRow, len(rows))
+	for _, row := range rows {
+		byKey[row.key()] = row
+	}
+
+	values := make([]attr.Value, 0, len(rows))
+	used := make(map[string]bool, len(rows))
+	for _, s := range state {
+		key := s.Filter.ValueString()
+		row, ok := byKey[key]
+		if !ok || used[key] {
+			continue
+		}
+		used[key] = true
+		values = append(values, virtualTagSourceValue(ctx, row, s, diags))
+	}
+	for _, row := range rows {
+		if !used[row.key()] {
+			values = append(values, virtualTagSourceValue(ctx, row, nil, diags))
+		}
+	}
+	if diags.HasError() {
+		return types.ListUnknown(elemType)
+	}
+
+	l, d := types.ListValue(elemType, values)
+	diags.Append(d...)
+	return l
+}
+
+// virtualTagSourceValue builds the planned value for row. prior is the value
+// in state with the same key, or nil for a new row.
+func virtualTagSourceValue(ctx context.Context, row virtualTagSourceRow, prior *virtualTagConfigValueModel, diags *diag.Diagnostics) attr.Value {
+	filter := row.key()
+	payload := &modelsv2.VirtualTagConfigValue{Filter: &filter}
+	if row.Name != "" {
+		payload.Name = &row.Name
+	}
+	if row.BusinessMetricToken != "" {
+		payload.BusinessMetricToken = &row.BusinessMetricToken
+	}
+	if row.DisplayName != "" {
+		payload.DisplayName = &row.DisplayName
+	}
+	for _, p := range row.Percentages {
+		payload.Percentages = append(payload.Percentages, &modelsv2.VirtualTagConfigValuePercentage{Value: p.Value, Pct: p.Pct})
+	}
+	if prior != nil {
+		payload.Token = prior.Token.ValueString()
+	}
+
+	obj, d := buildValueFromPayload(ctx, payload)
+	diags.Append(d...)
+	if d.HasError() {
+		return obj
+	}
+
+	attrs := obj.Attributes()
+	if prior == nil {
+		attrs["token"] = types.StringUnknown()
+	}
+	// The API names percentage values that don't set a display name.
+	if len(row.Percentages) > 0 && row.DisplayName == "" {
+		attrs["display_name"] = types.StringUnknown()
+		if prior != nil && !prior.DisplayName.IsNull() {
+			attrs["display_name"] = prior.DisplayName
+		}
+	}
+
+	v, d := basetypes.NewObjectValue(obj.AttributeTypes(ctx), attrs)
+	diags.Append(d...)
+	return v
+}
+
+// validateVirtualTagValuesSource parses a configured values_source, reporting
+// errors against the attribute. known is false when the source or any part
+// of it is unknown; rows is nil when the source is unset, unknown or invalid.
+func validateVirtualTagValuesSource(ctx context.Context, source types.Object, diags *diag.Diagnostics) (rows []virtualTagSourceRow, known bool) {
+	if source.IsUnknown() {
+		return nil, false
+	}
+	if source.IsNull() {
+		return nil, true
+	}
+	var m virtualTagValuesSourceModel
+	diags.Append(source.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, true
+	}
+	if m.Format.IsUnknown() || m.Content.IsUnknown() {
+		return nil, false
+	}
+
+	rows, err := parseVirtualTagValuesSource(m.Format.ValueString(), m.Content.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("values_source").AtName("content"), "Invalid Virtual Tag Values Source", err.Error())
+		return nil, true
+	}
+	return rows, true
+}
@@ -0,0 +1,102 @@
+package vantage
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	modelsv2 "github.com/vantage-sh/vantage-go/vantagev2/models"
+)
+
+func TestParseVirtualTagValuesSource_csv(t *testing.T) {
+	content := `account_id,filter,name,percentages
+123456789012,,Platform,
+,costs.service = 'Amazon S3',,platform:60; data:40
+`
+	rows, err := parseVirtualTagValuesSource("csv", content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+	if got := rows[0].key(); got != "(costs.account_id = '123456789012')" {
+		t.Errorf("unexpected account key %q", got)
+	}
+	if len(rows[1].Percentages) != 2 || rows[1].Percentages[1].Value != "data" || rows[1].Percentages[1].Pct != 40 {
+		t.Errorf("unexpected percentages %+v", rows[1].Percentages)
+	}
+}
+
+func TestParseVirtualTagValuesSource_errors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		format, content, want string
+	}{
+		"unknown column": {"csv", "filter,owner\nx,y\n", `unknown column "owner"`},
+		"no key":         {"csv", "filter,name\n,Platform\n", "row 2: exactly one of filter or account_id"},
+		"two kinds":      {"json", `[{"filter": "x", "name": "a", "business_metric_token": "bm"}]`, "row 1: exactly one of name"},
+		"duplicate key":  {"json", `[{"account_id": "1", "name": "a"}, {"account_id": "1", "name": "b"}]`, "row 2: duplicates the key"},
+		"bad percentage": {"csv", "filter,percentages\nx,platform=60\n", "row 2: percentage"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseVirtualTagValuesSource(tc.format, tc.content)
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("expected error containing %q, got %v", tc.want, err)
+			}
+		})
+	}
+}
+
+func TestVirtualTagSourceValues_keepsTokensAndPositions(t *testing.T) {
+	ctx := context.Background()
+	stateValue := func(token, filter, name string) *virtualTagConfigValueModel {
+		obj, diags := buildValueFromPayload(ctx, &modelsv2.VirtualTagConfigValue{Token: token, Filter: &filter, Name: &name})
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		var m virtualTagConfigValueModel
+		if diags := obj.As(ctx, &m, basetypes.ObjectAsOptions{}); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return &m
+	}
+	state := []*virtualTagConfigValueModel{
+		stateValue("vtag_val_b", "(costs.account_id = '2')", "Data"),
+		stateValue("vtag_val_a", "(costs.account_id = '1')", "Platform"),
+		stateValue("vtag_val_gone", "(costs.account_id = '9')", "Old"),
+	}
+	rows, err := parseVirtualTagValuesSource("csv", "account_id,name\n1,Platform\n3,Security\n2,Data Eng\n")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var diags diag.Diagnostics
+	planned := &virtualTagConfigModel{}
+	planned.Values = virtualTagSourceValues(ctx, rows, state, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	values := planned.valuesFromTf(ctx, &diags)
+	if len(values) != 3 {
+		t.Fatalf("expected 3 values, got %d", len(values))
+	}
+
+	if values[0].Token.ValueString() != "vtag_val_b" || values[0].Name.ValueString() != "Data Eng" {
+		t.Errorf("expected the changed row to keep its token and position, got %s %s", values[0].Token, values[0].Name)
+	}
+	if values[1].Token.ValueString() != "vtag_val_a" || !values[1].equal(state[1]) {
+		t.Errorf("expected the unchanged row to equal its state value")
+	}
+	if !values[2].Token.IsUnknown() || values[2].Name.ValueString() != "Security" {
+		t.Errorf("expected the new row appended with an unknown token, got %s %s", values[2].Token, values[2].Name)
+	}
+
+	// The removed row's value is reused for the new row, so both are sent
+	// as granular updates and the unchanged row is not sent at all.
+	changes := diffVirtualTagConfigValues(values, state)
+	if changes.requiresParentUpdate || len(changes.updates) != 2 || len(changes.creates) != 0 || len(changes.deletes) != 0 {
+		t.Errorf("expected two granular updates, got %#v", changes)
+	}
+}
@@ -0,0 +1,298 @@
+package vantage
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// vqlNode is a parsed VQL expression: either a condition, or a group of
+// child expressions joined by Op ("AND" or "OR").
+type vqlNode struct {
+	Op       string
+	Children []*vqlNode
+
+	// Pair marks a parenthesized tags.name AND tags.value group. The value
+	// belongs to that tag name, so the group is never merged into an
+	// enclosing AND.
+	Pair bool
+
+	// Condition fields. A condition with an `=`/`!=` comparison is stored as
+	// an IN/NOT IN with a single value so the two forms compare equal.
+	Field  string
+	Cmp    string
+	Values []string
+}
+
+// canonicalVQL parses filter and renders it in a canonical form: keywords are
+// upper case, AND and OR groups are flattened and their terms sorted, and the
+// values of IN lists are sorted and deduplicated. Two filters with the same
+// canonical form match the same costs.
+func canonicalVQL(filter string) (string, error) {
+	p := &vqlParser{}
+	if err := p.tokenize(filter); err != nil {
+		return "", err
+	}
+	if len(p.tokens) == 0 {
+		return "", nil
+	}
+	n, err := p.parseOr()
+	if err != nil {
+		return "", err
+	}
+	if p.pos != len(p.tokens) {
+		return "", fmt.Errorf("unexpected %q", p.tokens[p.pos])
+	}
+	return n.canonical(true), nil
+}
+
+// equivalentVQL reports whether two filters are the same expression up to
+// formatting, term order and value order. Filters that can't be parsed are
+// compared ignoring whitespace.
+func equivalentVQL(a, b string) bool {
+	ca, errA := canonicalVQL(a)
+	cb, errB := canonicalVQL(b)
+	if errA == nil && errB == nil {
+		return ca == cb
+	}
+	return strings.Join(strings.Fields(a), " ") == strings.Join(strings.Fields(b), " ")
+}
+
+// equivalentFilter returns prior when it is equivalent to the filter read
+// from the API, so a filter the API or the console reformats doesn't show as
+// drift, and current otherwise.
+func equivalentFilter(prior, current types.String) types.String {
+	if prior.IsNull() || prior.IsUnknown() || current.IsNull() || current.IsUnknown() {
+		return current
+	}
+	if equivalentVQL(prior.ValueString(), current.ValueString()) {
+		return prior
+	}
+	return current
+}
+
+func (n *vqlNode) canonical(top bool) string {
+	if n.Op == "" {
+		values := slices.Clone(n.Values)
+		slices.Sort(values)
+		values = slices.Compact(values)
+		if n.Cmp == "IN" || n.Cmp == "NOT IN" {
+			return fmt.Sprintf("%s %s (%s)", n.Field, n.Cmp, strings.Join(values, ", "))
+		}
+		return fmt.Sprintf("%s %s %s", n.Field, n.Cmp, values[0])
+	}
+
+	var terms []string
+	var collect func(c *vqlNode)
+	collect = func(c *vqlNode) {
+		if c.Op == n.Op && !c.Pair {
+			for _, gc := range c.Children {
+				collect(gc)
+			}
+			return
+		}
+		terms = append(terms, c.canonical(false))
+	}
+	for _, c := range n.Children {
+		collect(c)
+	}
+	slices.Sort(terms)
+	terms = slices.Compact(terms)
+	if len(terms) == 1 {
+		return terms[0]
+	}
+	s := strings.Join(terms, " "+n.Op+" ")
+	if top {
+		return s
+	}
+	return "(" + s + ")"
+}
+
+// isTagPair reports whether n is an AND of a tags.name and a tags.value
+// condition.
+func (n *vqlNode) isTagPair() bool {
+	if n.Op != "AND" {
+		return false
+	}
+	var name, value bool
+	for _, c := range n.Children {
+		switch c.Field {
+		case "tags.name":
+			name = true
+		case "tags.value":
+			value = true
+		}
+	}
+	return name && value
+}
+
+type vqlParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *vqlParser) tokenize(s string) error {
+	for i := 0; i < len(s); {
+		c := rune(s[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '(' || c == ')' || c == ',':
+			p.tokens = append(p.tokens, string(c))
+			i++
+		case c == '\'' || c == '"':
+			j := i + 1
+			for ; j < len(s) && rune(s[j]) != c; j++ {
+				if s[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(s) {
+				return fmt.Errorf("unterminated string starting at %d", i)
+			}
+			p.tokens = append(p.tokens, "'"+s[i+1:j]+"'")
+			i = j + 1
+		case strings.ContainsRune("=!<>", c):
+			j := i + 1
+			if j < len(s) && s[j] == '=' {
+				j++
+			}
+			p.tokens = append(p.tokens, s[i:j])
+			i = j
+		default:
+			j := i
+			for j < len(s) && !unicode.IsSpace(rune(s[j])) && !strings.ContainsRune("()',=!<>\"", rune(s[j])) {
+				j++
+			}
+			if j == i {
+				return fmt.Errorf("unexpected %q at %d", c, i)
+			}
+			word := s[i:j]
+			switch upper := strings.ToUpper(word); upper {
+			case "AND", "OR", "NOT", "IN", "LIKE":
+				word = upper
+			}
+			p.tokens = append(p.tokens, word)
+			i = j
+		}
+	}
+	return nil
+}
+
+func (p *vqlParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *vqlParser) next() (string, error) {
+	if p.pos >= len(p.tokens) {
+		return "", fmt.Errorf("unexpected end of filter")
+	}
+	t := p.tokens[p.pos]
+	p.pos++
+	return t, nil
+}
+
+func (p *vqlParser) parseOr() (*vqlNode, error) {
+	return p.parseJoined("OR", p.parseAnd)
+}
+
+func (p *vqlParser) parseAnd() (*vqlNode, error) {
+	return p.parseJoined("AND", p.parseTerm)
+}
+
+func (p *vqlParser) parseJoined(op string, parse func() (*vqlNode, error)) (*vqlNode, error) {
+	first, err := parse()
+	if err != nil {
+		return nil, err
+	}
+	n := &vqlNode{Op: op, Children: []*vqlNode{first}}
+	for p.peek() == op {
+		p.pos++
+		c, err := parse()
+		if err != nil {
+			return nil, err
+		}
+		n.Children = append(n.Children, c)
+	}
+	if len(n.Children) == 1 {
+		return first, nil
+	}
+	return n, nil
+}
+
+func (p *vqlParser) parseTerm() (*vqlNode, error) {
+	if p.peek() == "(" {
+		p.pos++
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if t, err := p.next(); err != nil || t != ")" {
+			return nil, fmt.Errorf("expected )")
+		}
+		n.Pair = n.isTagPair()
+		return n, nil
+	}
+
+	field, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	cmp, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if cmp == "NOT" {
+		t, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		cmp = "NOT " + t
+	}
+
+	n := &vqlNode{Field: field, Cmp: cmp}
+	switch cmp {
+	case "IN", "NOT IN":
+		if t, err := p.next(); err != nil || t != "(" {
+			return nil, fmt.Errorf("expected ( after %s", cmp)
+		}
+		for {
+			v, err := p.next()
+			if err != nil {
+				return nil, err
+			}
+			n.Values = append(n.Values, v)
+			t, err := p.next()
+			if err != nil {
+				return nil, err
+			}
+			if t == ")" {
+				break
+			}
+			if t != "," {
+				return nil, fmt.Errorf("expected , or ) in %s list", cmp)
+			}
+		}
+	case "=", "!=", "<", ">", "<=", ">=", "LIKE", "NOT LIKE":
+		v, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		n.Values = []string{v}
+		switch cmp {
+		case "=":
+			n.Cmp = "IN"
+		case "!=":
+			n.Cmp = "NOT IN"
+		}
+	default:
+		return nil, fmt.Errorf("unexpected %q after %s", cmp, field)
+	}
+	return n, nil
+}
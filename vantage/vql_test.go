@@ -0,0 +1,82 @@
+package vantage
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCanonicalVQL(t *testing.T) {
+	for filter, want := range map[string]string{
+		"costs.provider = 'aws'":                         "costs.provider IN ('aws')",
+		"costs.provider in ('gcp','aws', 'aws')":         "costs.provider IN ('aws', 'gcp')",
+		"costs.provider != \"aws\"":                      "costs.provider NOT IN ('aws')",
+		"costs.service = 'b' and costs.provider = 'a'":   "costs.provider IN ('a') AND costs.service IN ('b')",
+		"(costs.region = 'x' OR (costs.region = 'y'))":   "costs.region IN ('x') OR costs.region IN ('y')",
+		"a = '1' AND (b = '2' AND c > 3)":                "a IN ('1') AND b IN ('2') AND c > 3",
+		"a = '1' OR (b = '2' AND c LIKE '%x%')":          "(b IN ('2') AND c LIKE '%x%') OR a IN ('1')",
+		"tags.name = 'team' AND tags.value NOT IN ('a')": "tags.name IN ('team') AND tags.value NOT IN ('a')",
+		"  ": "",
+	} {
+		got, err := canonicalVQL(filter)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", filter, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("%q: expected %q, got %q", filter, want, got)
+		}
+	}
+
+	for _, filter := range []string{
+		"costs.provider =",
+		"costs.provider IN 'aws'",
+		"(costs.provider = 'aws'",
+		"costs.provider = 'aws",
+		"costs.provider = 'aws' costs.service = 'x'",
+	} {
+		if _, err := canonicalVQL(filter); err == nil {
+			t.Errorf("%q: expected an error", filter)
+		}
+	}
+}
+
+func TestEquivalentVQL(t *testing.T) {
+	a := "(costs.provider = 'aws' AND costs.service IN ('AmazonEC2', 'AmazonS3'))"
+	b := "costs.service in ('AmazonS3','AmazonEC2') and costs.provider = 'aws'"
+	if !equivalentVQL(a, b) {
+		t.Errorf("expected %q and %q to be equivalent", a, b)
+	}
+	if equivalentVQL(a, "costs.provider = 'aws' OR costs.service IN ('AmazonEC2', 'AmazonS3')") {
+		t.Errorf("expected AND and OR not to be equivalent")
+	}
+
+	// Each tag value belongs to the tag name it is paired with.
+	tags := "(tags.name = 'env' AND tags.value = 'prod') AND (tags.name = 'team' AND tags.value = 'a')"
+	if swapped := "(tags.name = 'env' AND tags.value = 'a') AND (tags.name = 'team' AND tags.value = 'prod')"; equivalentVQL(tags, swapped) {
+		t.Errorf("expected %q and %q not to be equivalent", tags, swapped)
+	}
+	if reordered := "(tags.value = 'a' AND tags.name = 'team') AND (tags.name='env' AND tags.value='prod')"; !equivalentVQL(tags, reordered) {
+		t.Errorf("expected %q and %q to be equivalent", tags, reordered)
+	}
+	if !equivalentVQL("costs.provider = 'aws", "costs.provider  =  'aws") {
+		t.Errorf("expected unparseable filters differing only in whitespace to be equivalent")
+	}
+}
+
+func TestEquivalentFilter(t *testing.T) {
+	prior := types.StringValue("costs.provider = 'aws' AND costs.region = 'us-east-1'")
+
+	got := equivalentFilter(prior, types.StringValue("(costs.region = 'us-east-1' AND costs.provider = 'aws')"))
+	if !got.Equal(prior) {
+		t.Errorf("expected the prior filter to be kept, got %v", got)
+	}
+
+	current := types.StringValue("costs.provider = 'gcp'")
+	if got := equivalentFilter(prior, current); !got.Equal(current) {
+		t.Errorf("expected the API's filter, got %v", got)
+	}
+	if got := equivalentFilter(types.StringUnknown(), current); !got.Equal(current) {
+		t.Errorf("expected the API's filter for an unknown prior, got %v", got)
+	}
+}